@@ -0,0 +1,336 @@
+package alert
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/datastore"
+	"github.com/alexgear/checker/process"
+	"github.com/alexgear/checker/query"
+)
+
+// The states of an alert. An alert is pending while the comparison of its
+// rule holds, firing once it held long enough, and resolved once it no
+// longer holds.
+const (
+	Pending  = "pending"
+	Firing   = "firing"
+	Resolved = "resolved"
+)
+
+// metrics are what rules can compare next to the latency and probe fields.
+var metrics = map[string]bool{"uptime": true, "failures": true, "count": true}
+
+// aggregations are the statistics of the latency a rule can compare.
+var aggregations = map[string]bool{
+	"mean": true, "stddev": true, "min": true, "max": true,
+	"p50": true, "p90": true, "p95": true, "p99": true, "p999": true,
+}
+
+// Alert is the state of a rule for a series.
+type Alert struct {
+	Rule       string     `json:"rule"`
+	Series     string     `json:"series"`
+	State      string     `json:"state"`
+	Value      float64    `json:"value"`    // of the metric at the latest evaluation
+	ActiveAt   time.Time  `json:"activeAt"` // when the comparison started to hold
+	FiredAt    *time.Time `json:"firedAt,omitempty"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// active reports whether the comparison of the rule held at the latest
+// evaluation.
+func (a *Alert) active() bool {
+	return a.State == Pending || a.State == Firing
+}
+
+// rule is a rule of the config along with the query statistic it compares
+// and the parts of its series pattern.
+type rule struct {
+	config.Rule
+	stat    string
+	pattern []string
+}
+
+// holds compares v to the threshold of the rule. Firing alerts compare to
+// the threshold moved by the hysteresis, so that values that hover around
+// it don't resolve and fire them over and over.
+func (r *rule) holds(v float64, firing bool) bool {
+	t := r.Threshold
+	if firing {
+		if r.Comparator[0] == '>' {
+			t -= r.Hysteresis
+		} else {
+			t += r.Hysteresis
+		}
+	}
+	switch r.Comparator {
+	case ">":
+		return v > t
+	case ">=":
+		return v >= t
+	case "<":
+		return v < t
+	default:
+		return v <= t
+	}
+}
+
+// splitPattern splits a series pattern into the patterns of agent,
+// interface, probe and target.
+func splitPattern(pattern string) ([]string, error) {
+	parts := strings.SplitN(pattern, "/", 4)
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("expected agent/interface/probe/target")
+	}
+	for _, part := range parts {
+		if _, err := path.Match(part, ""); err != nil {
+			return nil, err
+		}
+	}
+	return parts, nil
+}
+
+// matches reports whether the rule applies to a series. Every part of the
+// key is matched with the part of the pattern, and since targets like URLs
+// have slashes of their own, a * matches slashes in the target.
+func (r *rule) matches(store datastore.Store, series string) (bool, error) {
+	if r.Series != "" {
+		s, err := common.ParseSeries(series)
+		if err != nil {
+			return false, err
+		}
+		for i, part := range []string{s.Agent, s.Interface, s.Probe, s.Target} {
+			pattern := r.pattern[i]
+			if i == 3 {
+				pattern = strings.Replace(pattern, "/", "\x00", -1)
+				part = strings.Replace(part, "/", "\x00", -1)
+			}
+			if ok, _ := path.Match(pattern, part); !ok {
+				return false, nil
+			}
+		}
+	}
+	if len(r.Labels) == 0 {
+		return true, nil
+	}
+	labels, err := store.Labels(series)
+	if err != nil {
+		return false, err
+	}
+	for name, value := range r.Labels {
+		if labels[name] != value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type key struct {
+	rule, series string
+}
+
+// Engine evaluates rules against the aggregates of a store, keeping the
+// state of the alert of every rule and series, and the history of their
+// changes in the store. It is safe for concurrent use.
+type Engine struct {
+	mu       sync.Mutex
+	store    datastore.Store
+	rules    []*rule
+	lookback time.Duration
+	alerts   map[key]*Alert
+	onEvent  func(common.AlertEvent)
+}
+
+// New returns an engine for rules, picking up the state of their alerts
+// from the latest events in store. Series that did not report within
+// lookback are left alone rather than counted as down.
+func New(store datastore.Store, rules []config.Rule, lookback time.Duration) (*Engine, error) {
+	e := &Engine{store: store, lookback: lookback, alerts: make(map[key]*Alert)}
+	names := make(map[string]bool)
+	for _, c := range rules {
+		r := &rule{Rule: c, stat: c.Metric}
+		if c.Metric == "latency" {
+			r.stat = c.Aggregation
+			if r.stat == "" {
+				r.stat = "mean"
+			}
+			if !aggregations[r.stat] {
+				return nil, fmt.Errorf("Rule %q has unknown aggregation %q", c.Name, c.Aggregation)
+			}
+		} else if c.Aggregation != "" {
+			return nil, fmt.Errorf("Rule %q has an aggregation, only latency has them", c.Name)
+		} else if !metrics[c.Metric] && !(strings.HasPrefix(c.Metric, "field.") && len(c.Metric) > len("field.")) {
+			return nil, fmt.Errorf("Rule %q has unknown metric %q", c.Name, c.Metric)
+		}
+		if c.Series != "" {
+			var err error
+			r.pattern, err = splitPattern(c.Series)
+			if err != nil {
+				return nil, fmt.Errorf("Rule %q has invalid series pattern %q: %s", c.Name, c.Series, err.Error())
+			}
+		}
+		names[c.Name] = true
+		e.rules = append(e.rules, r)
+	}
+	events, err := store.LatestAlertEvents()
+	if err != nil {
+		return nil, err
+	}
+	// Only the latest change is known, so a firing alert is taken to have
+	// become active when it fired
+	for _, ev := range events {
+		if !names[ev.Rule] {
+			continue
+		}
+		t := ev.Time
+		a := &Alert{Rule: ev.Rule, Series: ev.Series, State: ev.State, Value: ev.Value, ActiveAt: t}
+		switch ev.State {
+		case Firing:
+			a.FiredAt = &t
+		case Resolved:
+			a.ResolvedAt = &t
+		}
+		e.alerts[key{ev.Rule, ev.Series}] = a
+	}
+	return e, nil
+}
+
+// OnEvent makes the engine call fn with every change of an alert. It must
+// be called before the engine is used.
+func (e *Engine) OnEvent(fn func(common.AlertEvent)) {
+	e.onEvent = fn
+}
+
+// Evaluate computes the metric of every rule over its window before now for
+// the series it applies to, and changes the state of their alerts. Series
+// without data in the window that reported within the lookback have an
+// uptime and counts of 0, and keep the state they are in for the other
+// metrics. Series that fail to evaluate are logged and skipped.
+func (e *Engine) Evaluate(now time.Time) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	series, err := e.store.ListSeries()
+	if err != nil {
+		return err
+	}
+	for _, r := range e.rules {
+		for _, s := range series {
+			ok, err := r.matches(e.store, s)
+			if err == nil && ok {
+				var v float64
+				v, ok, err = e.value(r, s, now)
+				if err == nil && ok {
+					e.update(r, s, v, now)
+				}
+			}
+			if err != nil {
+				log.Printf("Failed to evaluate rule %s for %s: %s\n", r.Name, s, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// value computes the metric of a rule for a series.
+func (e *Engine) value(r *rule, series string, now time.Time) (float64, bool, error) {
+	// A tier at a 60th of the window, the per-second one for short
+	// windows, leaves out little of its start
+	step := r.Window.Duration / 60
+	if step < time.Second {
+		step = time.Second
+	}
+	status, err := e.store.Query(series, now.Add(-r.Window.Duration), now, step)
+	if err == datastore.ErrUnknownSeries {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if len(status) == 0 {
+		if !metrics[r.stat] {
+			return 0, false, nil
+		}
+		// A series that went silent was up for none of the window, one
+		// that stopped reporting before the lookback was retired
+		recent, err := e.store.Query(series, now.Add(-e.lookback), now, e.lookback)
+		return 0, len(recent) > 0, err
+	}
+	s, err := process.Compute(status)
+	if err != nil {
+		return 0, false, err
+	}
+	v, ok := query.Value(s, r.stat)
+	return v, ok, nil
+}
+
+func (e *Engine) update(r *rule, series string, v float64, now time.Time) {
+	k := key{r.Name, series}
+	a := e.alerts[k]
+	if a != nil {
+		a.Value = v
+	}
+	switch holds := r.holds(v, a != nil && a.State == Firing); {
+	case holds && (a == nil || !a.active()):
+		a = &Alert{Rule: r.Name, Series: series, State: Pending, Value: v, ActiveAt: now}
+		e.alerts[k] = a
+		if r.For.Duration > 0 {
+			e.record(a, now)
+			return
+		}
+		e.fire(a, now)
+	case holds && a.State == Pending && now.Sub(a.ActiveAt) >= r.For.Duration:
+		e.fire(a, now)
+	case !holds && a != nil && a.active():
+		if a.State == Firing {
+			log.Printf("Alert %s resolved for %s: %g\n", a.Rule, a.Series, v)
+		}
+		a.State = Resolved
+		a.ResolvedAt = &now
+		e.record(a, now)
+	}
+}
+
+func (e *Engine) fire(a *Alert, now time.Time) {
+	log.Printf("Alert %s firing for %s: %g\n", a.Rule, a.Series, a.Value)
+	a.State = Firing
+	a.FiredAt = &now
+	e.record(a, now)
+}
+
+// record adds the state of a to the history and hands it to onEvent.
+func (e *Engine) record(a *Alert, now time.Time) {
+	ev := common.AlertEvent{Rule: a.Rule, Series: a.Series, State: a.State, Time: now, Value: a.Value}
+	err := e.store.AddAlertEvent(&ev)
+	if err != nil {
+		log.Printf("Failed to store alert event of %s: %s\n", a.Rule, err.Error())
+	}
+	if e.onEvent != nil {
+		e.onEvent(ev)
+	}
+}
+
+// Alerts returns the alerts of every rule and series that ever left the
+// inactive state, sorted by rule and series.
+func (e *Engine) Alerts() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	alerts := make([]Alert, 0, len(e.alerts))
+	for _, a := range e.alerts {
+		alerts = append(alerts, *a)
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Rule == alerts[j].Rule {
+			return alerts[i].Series < alerts[j].Series
+		}
+		return alerts[i].Rule < alerts[j].Rule
+	})
+	return alerts
+}
@@ -0,0 +1,118 @@
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/datastore"
+)
+
+func TestRuleMatches(t *testing.T) {
+	store := datastore.NewMemory()
+	for _, test := range []struct {
+		pattern, series string
+		want            bool
+	}{
+		{"*/wlan0/*/*", "laptop/wlan0/icmp/192.168.1.1", true},
+		{"*/wlan0/*/*", "laptop/eth0/icmp/192.168.1.1", false},
+		{"*/*/http/*", "laptop/wlan0/http/https://example.com/health", true},
+		{"*/*/http/https://example.com/*", "laptop/wlan0/http/https://example.com/api/v1/health", true},
+		{"*/*/http/https://*.example.com/*", "laptop/wlan0/http/https://example.com/health", false},
+		{"laptop/*/*/*", "desktop/wlan0/icmp/192.168.1.1", false},
+		{"*/*/*/192.168.1.?", "laptop/wlan0/icmp/192.168.1.1", true},
+	} {
+		r := &rule{Rule: config.Rule{Series: test.pattern}}
+		var err error
+		if r.pattern, err = splitPattern(test.pattern); err != nil {
+			t.Fatal(err)
+		}
+		got, err := r.matches(store, test.series)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s matching %s: got %t, want %t", test.pattern, test.series, got, test.want)
+		}
+	}
+	for _, pattern := range []string{"*", "*/wlan0/*", "*/[/*/*"} {
+		if _, err := splitPattern(pattern); err == nil {
+			t.Errorf("Expected an error for pattern %q", pattern)
+		}
+	}
+}
+
+func TestSilentSeriesFires(t *testing.T) {
+	store := datastore.NewMemory()
+	series := "laptop/eth0/icmp/192.168.1.1"
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	err := store.WriteAggregate(series, map[time.Time]common.Status{start: {Count: 1, Uptime: 100}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := New(store, []config.Rule{
+		{Name: "uptime", Series: "*/eth0/*/*", Metric: "uptime", Window: config.Duration{Duration: time.Minute}, Comparator: "<", Threshold: 99},
+		{Name: "latency", Series: "*/eth0/*/*", Metric: "latency", Window: config.Duration{Duration: time.Minute}, Comparator: ">", Threshold: 0.1},
+	}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, now := range []time.Time{start.Add(30 * time.Second), start.Add(5 * time.Minute)} {
+		if err = e.Evaluate(now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	alerts := e.Alerts()
+	if len(alerts) != 1 || alerts[0].Rule != "uptime" || alerts[0].State != Firing {
+		t.Errorf("Expected only the uptime alert to fire once the series went silent, got %+v", alerts)
+	}
+}
+
+func TestRetiredSeriesStaysQuiet(t *testing.T) {
+	store := datastore.NewMemory()
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	err := store.WriteAggregate("laptop/eth0/icmp/192.168.1.1", map[time.Time]common.Status{start: {Count: 1, Uptime: 100}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := New(store, []config.Rule{
+		{Name: "uptime", Metric: "uptime", Window: config.Duration{Duration: time.Minute}, Comparator: "<", Threshold: 99},
+	}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = e.Evaluate(start.Add(48 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if alerts := e.Alerts(); len(alerts) != 0 {
+		t.Errorf("Expected no alerts for a series that stopped reporting before the lookback, got %+v", alerts)
+	}
+}
+
+func TestNewRestoresLatestEvents(t *testing.T) {
+	store := datastore.NewMemory()
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	for i, state := range []string{Pending, Firing, Resolved, Pending, Firing} {
+		ev := common.AlertEvent{Rule: "uptime", Series: "laptop/eth0/icmp/192.168.1.1", State: state,
+			Time: start.Add(time.Duration(i) * time.Minute), Value: float64(i)}
+		if err := store.AddAlertEvent(&ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ev := common.AlertEvent{Rule: "removed", Series: "laptop/eth0/icmp/192.168.1.1", State: Firing, Time: start}
+	if err := store.AddAlertEvent(&ev); err != nil {
+		t.Fatal(err)
+	}
+	e, err := New(store, []config.Rule{
+		{Name: "uptime", Metric: "uptime", Window: config.Duration{Duration: time.Minute}, Comparator: "<", Threshold: 99},
+	}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts := e.Alerts()
+	firedAt := start.Add(4 * time.Minute)
+	if len(alerts) != 1 || alerts[0].State != Firing || alerts[0].Value != 4 || alerts[0].FiredAt == nil || !alerts[0].FiredAt.Equal(firedAt) {
+		t.Errorf("Expected the uptime alert firing since %s, got %+v", firedAt, alerts)
+	}
+}
@@ -1,116 +1,740 @@
 package api
 
 import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/alexgear/checker/alert"
 	"github.com/alexgear/checker/common"
 	"github.com/alexgear/checker/config"
 	"github.com/alexgear/checker/datastore"
+	"github.com/alexgear/checker/export"
+	"github.com/alexgear/checker/incident"
+	"github.com/alexgear/checker/metrics"
 	"github.com/alexgear/checker/process"
+	"github.com/alexgear/checker/query"
+	"github.com/alexgear/checker/sink"
+	"github.com/alexgear/checker/stream"
 	"github.com/gorilla/mux"
 )
 
-var err error
+// store is where the handlers read from, cache is where incoming results
+// go until they are flushed to store. Incoming results are also counted in
+// registry, which /metrics exposes, forwarded to sinks, published to the
+// subscribers of hub, along with the aggregates cache flushes, and watched
+// for outages by detector. engine evaluates the alert rules.
+var (
+	store    datastore.Store
+	cache    *datastore.Cache
+	registry = metrics.NewRegistry()
+	sinks    *sink.Group
+	hub      *stream.Hub
+	detector *incident.Detector
+	engine   *alert.Engine
+)
 
 func postDataHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-type", "application/json")
-	vars := mux.Vars(r)
 	r.ParseForm()
-	//log.Printf("postDataHandler: %#v", vars, r.Form)
+	series := common.Series{
+		Agent:     r.Form.Get("agent"),
+		Interface: r.Form.Get("interface"),
+		Probe:     r.Form.Get("probe"),
+		Target:    r.Form.Get("target"),
+	}
+	err := series.Validate()
+	if err != nil {
+		log.Println("Failed to parse series:", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	response := common.Response{}
-	response.Latency, err = time.ParseDuration(r.Form["latency"][0])
+	response.Latency, err = time.ParseDuration(r.Form.Get("latency"))
 	if err != nil {
 		log.Println("Failed to parse duration:", err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	response.Time, err = time.Parse(time.RFC3339Nano, r.Form["time"][0])
+	response.Time, err = time.Parse(time.RFC3339Nano, r.Form.Get("time"))
 	if err != nil {
 		log.Println("Failed to parse time:", err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	response.IsUp, err = strconv.ParseBool(r.Form["status"][0])
+	response.IsUp, err = strconv.ParseBool(r.Form.Get("status"))
 	if err != nil {
 		log.Println("Failed to parse status:", err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	err = datastore.Write(vars["ief"], response)
+	if e := r.Form.Get("error"); e != "" {
+		response.Error = e
+	}
+	labels := make(map[string]string)
+	for key, values := range r.Form {
+		if strings.HasPrefix(key, "label.") && len(values) > 0 {
+			labels[strings.TrimPrefix(key, "label.")] = values[0]
+		}
+	}
+	for key, values := range r.Form {
+		if !strings.HasPrefix(key, "field.") || len(values) == 0 {
+			continue
+		}
+		if response.Fields == nil {
+			response.Fields = make(map[string]float64)
+		}
+		response.Fields[strings.TrimPrefix(key, "field.")], err = strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			log.Println("Failed to parse field:", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	cache.SetLabels(series.Key(), labels)
+	err = cache.Write(series.Key(), response)
+	if err == nil {
+		err = cache.Sync()
+	}
 	if err != nil {
 		log.Println("Failed to write to db:", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	received(common.Result{Series: series, Labels: labels, Response: response})
 	return
 }
 
-// getGraphHandler writes a self-contained HTML page with an interactive plot
-// of the latencies from datastore, built with http://dygraphs.com/
-func getGraphHandler(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-type", "text/html")
-	vars := mux.Vars(r)
-	status, err := datastore.Read(vars["ief"], 24*time.Hour)
+// maxBatchSize limits the uncompressed size of a batch of results.
+const maxBatchSize = 64 << 20
+
+// received hands results that were written to the cache to the metrics,
+// the sinks, the subscribers of their samples and the incident detector.
+func received(results ...common.Result) {
+	for _, r := range results {
+		registry.Observe(r)
+	}
+	sinks.Write(results...)
+	hub.PublishSamples(results...)
+	detector.Observe(results...)
+}
+
+// postResultsHandler takes a batch of results as a JSON array, optionally
+// gzip compressed.
+func postResultsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			log.Println("Failed to decompress payload:", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	var results []common.Result
+	err := json.NewDecoder(io.LimitReader(body, maxBatchSize)).Decode(&results)
 	if err != nil {
-		log.Println("Failed to read from DB:", err.Error())
+		log.Println("Failed to decode payload:", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, result := range results {
+		if err = result.Series.Validate(); err != nil {
+			log.Println("Failed to parse series:", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	for _, result := range results {
+		key := result.Series.Key()
+		if result.Labels != nil {
+			cache.SetLabels(key, result.Labels)
+		}
+		err = cache.Write(key, result.Response)
+		if err != nil {
+			log.Println("Failed to write to db:", err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	// Only acknowledge the batch once it survives a crash, the agent drops
+	// it from its spool then
+	err = cache.Sync()
+	if err != nil {
+		log.Println("Failed to write to db:", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	_, err = fmt.Fprintf(w, plotsTemplateHead, asset(dygraphs))
+	received(results...)
+	fmt.Fprintf(w, "{\"accepted\":%d}\n", len(results))
+}
+
+// getExportHandler streams the raw samples of the "series" parameters, or
+// of all series, between "from" and "to" in the requested format. With
+// "aggregates=true" it streams the aggregates of every tier instead, which
+// only jsonl holds.
+func getExportHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	format := q.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	aggregates := q.Get("aggregates") == "true"
+	from, to, err := parseRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var enc export.Encoder
+	if aggregates && format != "jsonl" {
+		http.Error(w, "Aggregates are only exported as jsonl", http.StatusBadRequest)
+		return
+	} else if !aggregates {
+		enc, err = export.NewEncoder(w, format)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	series := q["series"]
+	for _, key := range series {
+		if _, err = store.Labels(key); err == datastore.ErrUnknownSeries {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+	}
+	w.Header().Set("Content-type", export.ContentType(format))
+	if aggregates {
+		n, err := export.ExportAggregates(w, store, series, from, to)
+		if err != nil {
+			log.Printf("Export failed after %d aggregates: %s\n", n, err.Error())
+			return
+		}
+		log.Printf("Exported %d aggregates to %s\n", n, r.RemoteAddr)
+		return
+	}
+	n, err := export.Export(enc, store, series, from, to)
+	if err != nil {
+		// Headers are out already, all we can do is cut the response short
+		log.Printf("Export failed after %d samples: %s\n", n, err.Error())
+		return
+	}
+	log.Printf("Exported %d samples to %s\n", n, r.RemoteAddr)
+}
+
+// postImportHandler adds the results in the body, in the format given by
+// the "format" parameter and optionally gzip compressed, to the db. With
+// "aggregates=true" the body holds aggregates as exported by
+// getExportHandler instead.
+func postImportHandler(w http.ResponseWriter, r *http.Request) {
+	if !authorized(r) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	w.Header().Set("Content-type", "application/json")
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	aggregates := r.URL.Query().Get("aggregates") == "true"
+	if aggregates && format != "jsonl" {
+		http.Error(w, "Aggregates are only imported from jsonl", http.StatusBadRequest)
+		return
+	}
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			log.Println("Failed to decompress payload:", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	if aggregates {
+		n, err := export.ImportAggregates(body, store)
+		if err != nil {
+			// What was read before the error stays imported
+			log.Printf("Import failed after %d aggregates: %s\n", n, err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.Printf("Imported %d aggregates from %s\n", n, r.RemoteAddr)
+		fmt.Fprintf(w, "{\"imported\":%d}\n", n)
+		return
+	}
+	dec, err := export.NewDecoder(body, format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Errors of the cache are the server's fault, the others the payload's
+	var writeErr error
+	written := 0
+	n, skipped, err := export.Import(dec, store, func(result common.Result) error {
+		key := result.Series.Key()
+		if result.Labels != nil {
+			cache.SetLabels(key, result.Labels)
+		}
+		writeErr = cache.Write(key, result.Response)
+		if writeErr == nil {
+			written++
+			if written%export.ImportFlushEvery == 0 {
+				writeErr = cache.Flush()
+			}
+		}
+		return writeErr
+	})
+	if err == nil {
+		// Everything imported goes to the db right away, however recent,
+		// so that importing it again finds it there and skips it
+		err = cache.FlushBefore(time.Now().Add(time.Hour))
+		writeErr = err
+	}
+	if err != nil {
+		// What was read before the error stays imported
+		log.Printf("Import failed after %d results: %s\n", n, err.Error())
+		status := http.StatusBadRequest
+		if writeErr != nil {
+			status = http.StatusInternalServerError
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	log.Printf("Imported %d results from %s, skipped %d already in the db\n", n-skipped, r.RemoteAddr, skipped)
+	fmt.Fprintf(w, "{\"imported\":%d,\"skipped\":%d}\n", n-skipped, skipped)
+}
+
+// parseRange reads the "from" and "to" query parameters, which default to
+// the last 24 hours.
+func parseRange(r *http.Request) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	from, to := now.Add(-24*time.Hour), now
+	var err error
+	if s := r.URL.Query().Get("from"); s != "" {
+		if from, err = query.ParseTime(s, now); err != nil {
+			return from, to, err
+		}
+	}
+	if s := r.URL.Query().Get("to"); s != "" {
+		if to, err = query.ParseTime(s, now); err != nil {
+			return from, to, err
+		}
+	}
+	return from, to, nil
+}
+
+// getQueryHandler returns the statistics named by "stats" of the "series"
+// parameters, or of all series, between "from" and "to" in buckets of
+// "step". It is what the graph page plots.
+func getQueryHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	q := r.URL.Query()
+	from, to, err := parseRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	step := to.Sub(from) / maxPoints
+	if step < time.Second {
+		step = time.Second
+	}
+	if s := q.Get("step"); s != "" {
+		step, err = query.ParseDuration(s)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to parse step: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+	}
+	stats, err := query.ParseStats(q.Get("stats"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := query.Request{Series: q["series"], From: from, To: to, Step: step, Stats: stats}
+	if err = req.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := query.Run(store, req)
+	if err == datastore.ErrUnknownSeries {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	err = json.NewEncoder(w).Encode(result)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// incidentResponse is an incident as /v1/incidents returns it.
+type incidentResponse struct {
+	common.Incident
+	End      *time.Time `json:"end"`      // null while ongoing
+	Duration float64    `json:"duration"` // seconds, up to now while ongoing
+	Ongoing  bool       `json:"ongoing"`
+}
+
+func newIncidentResponse(i common.Incident, now time.Time) incidentResponse {
+	resp := incidentResponse{Incident: i, Duration: i.Duration(now).Seconds(), Ongoing: i.Ongoing()}
+	if !i.Ongoing() {
+		resp.End = &i.End
+	}
+	return resp
+}
+
+// listIncidents returns the incidents between from and to that affected
+// any of series, or all of them if series is empty, latest first.
+func listIncidents(from, to time.Time, series []string) ([]common.Incident, error) {
+	all, err := store.Incidents(from, to)
+	if err != nil {
+		return nil, err
+	}
+	incidents := make([]common.Incident, 0, len(all))
+	for i := len(all) - 1; i >= 0; i-- {
+		if len(series) == 0 || affects(all[i], series) {
+			incidents = append(incidents, all[i])
+		}
+	}
+	return incidents, nil
+}
+
+func affects(i common.Incident, series []string) bool {
+	for _, a := range i.Series {
+		for _, b := range series {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getIncidentsHandler lists the incidents between "from" and "to" that
+// affected any of the "series" parameters, or all incidents, latest first.
+func getIncidentsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	from, to, err := parseRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	incidents, err := listIncidents(from, to, r.URL.Query()["series"])
 	if err != nil {
 		log.Println(err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	now := time.Now()
+	resp := make([]incidentResponse, len(incidents))
+	for i := range incidents {
+		resp[i] = newIncidentResponse(incidents[i], now)
+	}
+	err = json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		log.Println(err)
+	}
+}
 
-	buf := make([]byte, 0, 128)
-	buf = append(buf, "Seconds,Average,P90,P99"...)
-	buf = append(buf, `\n`...)
-	_, err = w.Write(buf)
-	buf = buf[:0]
-	for t, s := range status {
-		buf = append(buf, t.Format(time.RFC3339Nano)...)
-		buf = append(buf, ","...)
-		buf = append(buf, strconv.FormatFloat(s.Mean, 'f', -1, 32)...)
-		buf = append(buf, ","...)
-		buf = append(buf, strconv.FormatFloat(s.Percentile90, 'f', -1, 32)...)
-		buf = append(buf, ","...)
-		buf = append(buf, strconv.FormatFloat(s.Percentile99, 'f', -1, 32)...)
-		buf = append(buf, `\n`...)
+// getAlertsHandler lists the alerts of every rule and series that were
+// pending at some point, optionally only those of the "rule", "series" and
+// "state" parameters.
+func getAlertsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	q := r.URL.Query()
+	alerts := []alert.Alert{}
+	for _, a := range engine.Alerts() {
+		if matches(q, "rule", a.Rule) && matches(q, "series", a.Series) && matches(q, "state", a.State) {
+			alerts = append(alerts, a)
+		}
+	}
+	err := json.NewEncoder(w).Encode(alerts)
+	if err != nil {
+		log.Println(err)
+	}
+}
 
-		_, err = w.Write(buf)
+// getAlertHistoryHandler lists the changes of the alerts between "from" and
+// "to", latest first, optionally only those of the "rule" and "series"
+// parameters.
+func getAlertHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	q := r.URL.Query()
+	from, to, err := parseRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	all, err := store.AlertEvents(from, to)
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	events := make([]common.AlertEvent, 0, len(all))
+	for i := len(all) - 1; i >= 0; i-- {
+		if matches(q, "rule", all[i].Rule) && matches(q, "series", all[i].Series) {
+			events = append(events, all[i])
+		}
+	}
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// matches reports whether value is one of the values of the query parameter
+// name, or the parameter is not given.
+func matches(q url.Values, name, value string) bool {
+	values, ok := q[name]
+	if !ok {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// keepAlive is how often streams send something while there are no
+// events, so that proxies don't close them.
+const keepAlive = 15 * time.Second
+
+// getStreamHandler pushes the aggregates of the "series" parameters, or of
+// all series, as they are flushed, and with "raw" set also the samples as
+// they come in. Events are sent as Server-Sent Events, or as WebSocket
+// messages if the request asks for a WebSocket.
+func getStreamHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var raw bool
+	if s := q.Get("raw"); s != "" {
+		var err error
+		raw, err = strconv.ParseBool(s)
 		if err != nil {
-			log.Println(err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, fmt.Sprintf("Failed to parse raw: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+	}
+	for _, key := range q["series"] {
+		if _, err := common.ParseSeries(key); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if stream.IsWebSocket(r) {
+		streamWebSocket(w, r, q["series"], raw)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	sub := hub.Subscribe(q["series"], raw)
+	defer sub.Close()
+	w.Header().Set("Content-type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Keep nginx from buffering the events
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			b, err := json.Marshal(e)
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, b)
+			if err != nil {
+				return
+			}
+		case <-ticker.C:
+			_, err := fmt.Fprint(w, ": keep-alive\n\n")
+			if err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// streamWebSocket is getStreamHandler for WebSockets, every event is a text
+// message.
+func streamWebSocket(w http.ResponseWriter, r *http.Request, series []string, raw bool) {
+	conn, err := stream.Upgrade(w, r, config.C.StreamOrigins)
+	if err != nil {
+		log.Println("Failed to upgrade to WebSocket:", err.Error())
+		return
+	}
+	defer conn.Close()
+	sub := hub.Subscribe(series, raw)
+	defer sub.Close()
+	closed := make(chan error, 1)
+	go func() {
+		closed <- conn.ReadLoop()
+	}()
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-r.Context().Done():
 			return
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			b, err := json.Marshal(e)
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			if err = conn.WriteText(b); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err = conn.Ping(); err != nil {
+				return
+			}
 		}
+	}
+}
 
-		buf = buf[:0]
+// maxPoints is how many points per series a window is split into, unless a
+// step is requested explicitly.
+const maxPoints = 2000
+
+// parseWindow reads the time window of a request from the "range" (24h by
+// default) and "step" query parameters and returns it as from, to and step.
+func parseWindow(r *http.Request) (time.Time, time.Time, time.Duration, error) {
+	window := 24 * time.Hour
+	if s := r.URL.Query().Get("range"); s != "" {
+		d, err := query.ParseDuration(s)
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, fmt.Errorf("Failed to parse range: %s", err.Error())
+		}
+		window = d
+	}
+	step := window / maxPoints
+	if step < time.Second {
+		step = time.Second
+	}
+	if s := r.URL.Query().Get("step"); s != "" {
+		d, err := query.ParseDuration(s)
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, fmt.Errorf("Failed to parse step: %s", err.Error())
+		}
+		step = d
 	}
+	to := time.Now().UTC()
+	return to.Add(-window), to, step, nil
+}
 
-	_, err = fmt.Fprintf(w, plotsTemplateTail, vars["ief"])
+// getGraphHandler writes a self-contained HTML page with an interactive plot
+// of the latencies of a series, built with http://dygraphs.com/ from what
+// /v1/query would return for the window given by "range" and "step". The
+// page appends the aggregates /v1/stream pushes as they come in.
+func getGraphHandler(w http.ResponseWriter, r *http.Request) {
+	series := r.URL.Query().Get("series")
+	from, to, step, err := parseWindow(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Show the request phases as extra lines for probes that report them
+	stats := append([]string(nil), query.DefaultStats...)
+	for _, phase := range common.HTTPPhases {
+		stats = append(stats, "field."+phase)
+	}
+	req := query.Request{Series: []string{series}, From: from, To: to, Step: step, Stats: stats}
+	if err = req.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := query.Run(store, req)
+	if err == datastore.ErrUnknownSeries {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		log.Println(err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	return
+	points, err := json.Marshal(result)
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	window := "24h"
+	if s := r.URL.Query().Get("range"); s != "" {
+		window = s
+	}
+	streamURL, _ := json.Marshal("/v1/stream?" + url.Values{"series": {series}}.Encode())
+	title, _ := json.Marshal(fmt.Sprintf("%s latency for last %s", series, window))
+	w.Header().Set("Content-type", "text/html")
+	_, err = fmt.Fprintf(w, plotsTemplate, asset(dygraphs), streamURL, int64(to.Sub(from).Seconds()), points, title)
+	if err != nil {
+		log.Println(err)
+	}
 }
 
 // response structure to /status
 type getStatusResponse struct {
 	common.Status
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 func getStatusHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-type", "application/json")
-	vars := mux.Vars(r)
-	status, err := datastore.Read(vars["ief"], 24*time.Hour)
+	series := r.URL.Query().Get("series")
+	from, to, step, err := parseWindow(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := query.Request{From: from, To: to, Step: step}
+	if err = req.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	status, err := query.Read(store, series, req)
+	if err == datastore.ErrUnknownSeries {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		log.Println(err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -122,7 +746,16 @@ func getStatusHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	response := getStatusResponse{s}
+	labels, err := store.Labels(series)
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// The sketches are only useful for merging, don't bloat the response
+	s.Sketch = nil
+	s.PhaseSketches = nil
+	response := getStatusResponse{s, labels}
 	toWrite, err := json.Marshal(response)
 	if err != nil {
 		log.Println(err)
@@ -133,9 +766,100 @@ func getStatusHandler(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// getRawHandler returns the raw samples of a series for the duration given
+// by "since", the last hour by default.
+func getRawHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	since := time.Hour
+	if s := r.URL.Query().Get("since"); s != "" {
+		d, err := query.ParseDuration(s)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		since = d
+	}
+	now := time.Now().UTC()
+	samples, err := store.ReadRaw(r.URL.Query().Get("series"), now.Add(-since), now)
+	if err == datastore.ErrUnknownSeries {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if samples == nil {
+		samples = []common.Response{}
+	}
+	err = json.NewEncoder(w).Encode(samples)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// getSeriesHandler lists the keys of all series that have data.
+func getSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	series, err := store.ListSeries()
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if series == nil {
+		series = []string{}
+	}
+	err = json.NewEncoder(w).Encode(series)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// rootIncidents is how far back the root page lists incidents.
+const rootIncidents = 7 * 24 * time.Hour
+
+// rootIncident is a row of the incident list of the root page.
+type rootIncident struct {
+	Start, End, Duration string
+	Agent, FirstError    string
+	Series               []string
+}
+
 func getRootHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-type", "text/html")
-	_, err = fmt.Fprint(w, rootTemplate)
+	series, err := store.ListSeries()
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	now := time.Now()
+	incidents, err := listIncidents(now.Add(-rootIncidents), now, nil)
+	if err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rows := make([]rootIncident, len(incidents))
+	for n, i := range incidents {
+		rows[n] = rootIncident{
+			Start:      i.Start.Local().Format("2006-01-02 15:04:05"),
+			End:        "ongoing",
+			Duration:   i.Duration(now).Round(time.Second).String(),
+			Agent:      i.Agent,
+			FirstError: i.FirstError,
+			Series:     i.Series,
+		}
+		if !i.Ongoing() {
+			rows[n].End = i.End.Local().Format("2006-01-02 15:04:05")
+		}
+	}
+	err = rootTemplate.Execute(w, struct {
+		Series    []string
+		Incidents []rootIncident
+	}{series, rows})
 	if err != nil {
 		log.Println(err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -144,13 +868,121 @@ func getRootHandler(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
-func InitServer() error {
+// authorized reports whether r carries the admin token. Nothing is
+// authorized if no token is configured. The comparison takes as long
+// wherever the header differs, so it doesn't give the token away.
+func authorized(r *http.Request) bool {
+	if config.C.AdminToken == "" {
+		return false
+	}
+	got := []byte(r.Header.Get("Authorization"))
+	return subtle.ConstantTimeCompare(got, []byte("Bearer "+config.C.AdminToken)) == 1
+}
+
+// getBackupHandler streams a consistent snapshot of the db, to be restored
+// with "checker db restore".
+func getBackupHandler(w http.ResponseWriter, r *http.Request) {
+	if !authorized(r) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	b, ok := store.(datastore.Backuper)
+	if !ok {
+		http.Error(w, "Storage backend does not support backups", http.StatusNotImplemented)
+		return
+	}
+	w.Header().Set("Content-type", "application/octet-stream")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"checker-%s.db\"", time.Now().UTC().Format("20060102T150405Z")))
+	n, err := b.Backup(w)
+	if err != nil {
+		// Headers are out already, all we can do is cut the response short
+		log.Printf("Backup failed after %d bytes: %s\n", n, err.Error())
+		return
+	}
+	log.Printf("Sent a backup of %d bytes to %s\n", n, r.RemoteAddr)
+}
+
+// legacySeries returns the series that results of the interface ief, which
+// the first agents posted to /v1/{ief}, are kept under since, named like
+// "checker db migrate" names the buckets it moves. agent defaults to the
+// server's.
+func legacySeries(ief, agent string) common.Series {
+	series := common.Series{Agent: agent, Interface: ief, Probe: "tcp", Target: "8.8.8.8:53"}
+	if series.Agent == "" {
+		series.Agent = config.C.Agent
+	}
+	switch ief {
+	case "wifi":
+		series.Target = "8.8.4.4:53"
+		if config.C.WifiIef != "" {
+			series.Interface = config.C.WifiIef
+		}
+	case "lan":
+		if config.C.LanIef != "" {
+			series.Interface = config.C.LanIef
+		}
+	}
+	return series
+}
+
+// postLegacyDataHandler takes the results of agents that post to /v1/{ief}.
+func postLegacyDataHandler(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	series := legacySeries(mux.Vars(r)["ief"], r.Form.Get("agent"))
+	r.Form.Set("agent", series.Agent)
+	r.Form.Set("interface", series.Interface)
+	r.Form.Set("probe", series.Probe)
+	r.Form.Set("target", series.Target)
+	postDataHandler(w, r)
+}
+
+// legacyQuery serves the old /v1/{ief} and /v1/{ief}/status with h, which
+// takes the series from the query.
+func legacyQuery(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		q.Set("series", legacySeries(mux.Vars(r)["ief"], q.Get("agent")).Key())
+		r.URL.RawQuery = q.Encode()
+		h(w, r)
+	}
+}
+
+// InitServer returns the server of the api. It reads aggregates from s and
+// writes incoming results to c, and hands them to the sinks of g, the
+// subscribers of h and the detector d. e holds the alerts it lists.
+// Streams end when the server shuts down.
+func InitServer(s datastore.Store, c *datastore.Cache, g *sink.Group, h *stream.Hub, d *incident.Detector, e *alert.Engine) *http.Server {
+	store, cache, sinks, hub, detector, engine = s, c, g, h, d, e
 	router := mux.NewRouter().StrictSlash(true)
-	router.HandleFunc("/v1/{ief}", postDataHandler).Methods("POST")
-	router.HandleFunc("/v1/{ief}", getGraphHandler).Methods("GET")
-	router.HandleFunc("/v1/{ief}/status", getStatusHandler).Methods("GET")
+	router.HandleFunc("/v1/results", postDataHandler).Methods("POST")
+	router.HandleFunc("/v2/results", postResultsHandler).Methods("POST")
+	router.HandleFunc("/v1/series", getSeriesHandler).Methods("GET")
+	router.HandleFunc("/v1/graph", getGraphHandler).Methods("GET")
+	router.HandleFunc("/v1/query", getQueryHandler).Methods("GET")
+	router.HandleFunc("/v1/stream", getStreamHandler).Methods("GET")
+	router.HandleFunc("/v1/status", getStatusHandler).Methods("GET")
+	router.HandleFunc("/v1/raw", getRawHandler).Methods("GET")
+	router.HandleFunc("/v1/incidents", getIncidentsHandler).Methods("GET")
+	router.HandleFunc("/v1/alerts", getAlertsHandler).Methods("GET")
+	router.HandleFunc("/v1/alerts/history", getAlertHistoryHandler).Methods("GET")
+	router.HandleFunc("/v1/export", getExportHandler).Methods("GET")
+	router.HandleFunc("/v1/import", postImportHandler).Methods("POST")
+	router.HandleFunc("/admin/backup", getBackupHandler).Methods("GET")
+	// The routes of the first agents, which had no series
+	router.HandleFunc("/v1/{ief:wifi|lan}", postLegacyDataHandler).Methods("POST")
+	router.HandleFunc("/v1/{ief:wifi|lan}", legacyQuery(getGraphHandler)).Methods("GET")
+	router.HandleFunc("/v1/{ief:wifi|lan}/status", legacyQuery(getStatusHandler)).Methods("GET")
+	router.Handle("/metrics", registry).Methods("GET")
 	router.HandleFunc("/", getRootHandler).Methods("GET")
 	bind := fmt.Sprintf("%s:%d", config.C.ListenHost, config.C.ListenPort)
-	log.Println("listening on: ", bind)
-	return http.ListenAndServe(bind, router)
+	// Streams never go idle, Shutdown would wait for them forever
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &http.Server{
+		Addr:        bind,
+		Handler:     router,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	server.RegisterOnShutdown(cancel)
+	return server
 }
@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"compress/gzip"
+	"html/template"
 	"io"
 )
 
@@ -10,24 +11,36 @@ var (
 	dygraphs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xe4\xbd\x6b\x77\xdb\x48\xb2\x20\xf8\x79\xe7\x57\x50\xbc\xd3\x32\x20\x82\x14\xa9\xea\xaa\xdb\x4d\x18\xd2\xa8\x2c\x97\xcb\xd3\x76\xd9\x6b\xa9\xea\x5a\xcd\xd6\xf0\x40\x64\x8a\xc4\x18\x02\x38\x00\x28\x91\x25\xf1\xbf\x6f\x44\xe4\x1b\x48\x48\xaa\xee\x3b\x67\x76\xcf\x9e\xee\x92\x89\x7c\x3f\x22\x23\x23\x22\xe3\x71\x78\xb0\xd7\xf9\x6f\x69\x32\x63\x59\xc9\x3a\x6f\xf2\xd5\xb6\x48\x16\xcb\xaa\x73\x34\x1c\xfd\xb9\x73\x16\x67\x9d\xdf\xe2\x6c\xce\x8a\x6f\xf1\x6d\xc7\x9b\xc7\xd9\xdd\xfc\xdb\x7f\x5b\xdc\xc6\x49\x3a\x98\xe5\xb7\x7e\xe7\xe3\xfb\x8b\xbe\xa8\x3b\xef\x78\xcb\xaa\x5a\x8d\x0f\x0f\xf3\x15\x7c\xe7\xeb\x62\xc6\x06\x79\xb1\x38\x14\xf9\xe5\x21\x14\xf6\x3b\x07\x87\xff\x65\xef\x66\x9d\xcd\xaa\x24\xcf\xbc\xca\x7f\xe8\xae\xa1\xdb\xb2\x2a\x92\x59\xd5\x0d\x6f\xf2\xc2\xbb\x8b\x8b\x0e\x0b\xe2\x20\x89\x1e\x76\x41\x11\xa9\xb2\x3e\x7c\x66\x51\xf7\x96\xdd\xe6\xc5\xb6\x3b\x28\x57\x69\x52\x79\xdd\xa0\xeb\x07\x79\xd4\x8d\xcb\x92\x15\x55\x30\x4b\x59\x5c\x04\xb3\x7c\x9d\x55\xc1\x9c\x5d\xaf\x17\xc1\x3c\x29\xf0\xbf\xcd\x6d\x1a\xb0\xa2\xc8\x8b\x80\x6d\x66\x6c\x85\x0d\x06\x8b\x22\x5f\xaf\xf8\xdf\x37\x79\x9a\xc6\x2b\x98\x03\xff\x7c\x9b\xcd\x83\x24\xbb\xc9\x83\x34\x5f\x04\xb7\x71\xf1\xed\x22\xb9\x65\x69\x92\xb1\x60\x55\xe4\x37\x49\xaa\xfe\x2d\xe5\x0f\xac\x51\x2e\xf3\xfb\xa0\x8a\xaf\x21\xbb\x82\xf2\xf4\x07\xd3\x2b\x59\x59\xfe\x90\x89\xe7\x55\x7c\xbb\x0a\xaa\x22\x9e\xb1\xe0\x3e\x2e\x32\x73\x52\x21\x8b\xb2\xc1\x2a\x5f\x79\x7e\xe8\x57\x13\x76\x15\xe1\x9f\xc7\xc7\x84\x96\x28\x8c\xa3\x5c\x67\xc6\x98\x19\x43\x66\xb1\xf3\xaa\x65\x52\xc2\xce\xc0\xf2\xa7\x2c\x32\x3f\x1e\x1f\x1f\x76\x7e\x60\x2c\xa6\xb5\xee\x6f\x60\x63\xe3\xf2\x0b\xc3\x9d\x4e\xb2\xc5\x9b\x3c\xab\xd8\xa6\x3a\x3a\x1b\xc0\xf4\xaa\xbc\xda\xae\xd8\x20\xc9\xca\x2a\x4e\xd3\xcf\x71\x55\xb1\x22\x8b\xcc\x2d\x4c\x6e\xbc\xee\x1a\xaa\xde\xc0\xdc\xe6\xdd\xbd\x08\xcb\xe7\x37\x1d\xea\x3e\x29\x45\x8d\xf7\xbc\x3e\x9b\xfb\xd5\xb2\xc8\xef\xbb\x1f\xd7\x65\xd5\x59\x67\x7d\xd1\x6e\x27\x4f\xe7\x1d\x5c\x9c\xce\x8a\x97\xef\x5c\x33\x98\x2a\xeb\x88\x7c\x18\x55\x27\xee\x64\xec\xbe\x93\x67\x6c\xd0\x0d\x5b\x1a\x8f\xf6\x86\x21\x01\x50\x34\x19\x06\xc3\xab\x20\x8e\x26\x57\x00\x49\x54\xfa\x9a\x2d\x92\x0c\x2a\x2c\x01\xaa\x28\x01\xbb\xbb\xc8\x01\xa8\xe8\xeb\x36\xbf\xc3\xaf\x9c\x7f\xc1\xca\xe4\xdf\x18\xef\x66\x9d\xb5\x4d\xde\x7f\xb0\x5b\x8e\x92\xc0\x68\x39\x2a\x02\xa3\xe5\x28\x0b\x8c\x96\xa3\x3c\x70\xb7\x7d\x97\x27\xf3\xce\x30\x68\x9b\x1f\xcf\xde\x05\xb5\x7e\x8d\x11\xf1\x29\x0f\x66\x50\x9e\xc0\xc1\xdf\x59\xa3\xd0\x1b\x17\x30\x28\x3c\x58\xad\xcb\xa5\x37\x99\xc0\xd7\xd5\x95\x1f\x64\xba\x5e\x80\x05\x76\xd6\x7c\xec\xba\xb8\xce\x49\x14\x4f\xe2\x41\xca\xb2\x45\xb5\xec\x8f\xae\xc2\x44\xb4\x87\xcd\xc9\xca\x62\xc2\xc6\x10\x01\x60\x86\x51\x14\xc9\x8a\x7e\xc1\xaa\x35\xec\x38\xcd\x2d\x37\x46\xae\xf0\x41\x12\x0d\xc3\xe4\xb5\x2c\x1f\x26\xbd\x9e\x2f\xb3\x4a\x18\x41\x72\x15\xa4\x51\x39\x19\x5e\xc1\xff\x83\x25\xff\x35\xba\x0a\x56\xd1\x28\x5c\xbd\x2e\x65\xad\x15\xd4\xa2\x41\x2f\xa0\xc4\x8a\xca\xce\xf9\x2f\x18\x38\x1f\x69\x7c\xc7\xe0\x4c\x61\x99\x75\xb4\xe8\xa7\xc1\x2c\x9a\xf7\x97\xc1\x36\xfa\x08\x6b\x3c\x28\xff\x57\x51\x79\xeb\x83\x75\x6f\x76\x30\xf3\x83\x29\x4f\x8c\xab\x38\x3b\xf2\x66\xc1\xda\xe7\x4d\xc0\x69\xce\xca\x34\xae\x98\x97\x06\x4b\x7b\x3d\x01\x20\x7d\xbe\x22\x70\xa8\xb0\xc4\x54\x4f\xf0\x2e\x62\x38\x9e\x1b\x98\xe7\xf6\xf8\x26\xe4\xe3\xdc\xc0\xb9\xbe\xbb\x0a\x6f\x7a\x90\x39\xba\x3a\xc1\x3f\xe3\x4d\x70\x73\xbc\x3d\xf1\x00\xbe\xef\x82\x9b\xfe\x16\xab\x6c\xfd\x31\x7c\x7a\x77\xbd\x91\xff\xa7\x4a\x4c\x16\x61\xff\xee\x4f\x47\xb0\xc8\xc3\x93\xc2\x18\xc3\x0d\x8c\x61\x9c\xd5\x12\x82\xbb\xc8\xae\xbd\xe3\xa3\x64\x65\x05\x27\xd0\xf3\x61\x71\x17\xb0\xac\xf3\x9d\xb9\x37\x74\xb4\x76\xbb\xe0\x05\xa8\xe3\xe9\xf3\xa3\x90\x81\xc4\x04\xb1\x13\x0f\xa8\x46\x10\x13\x24\xd5\xa0\xbb\xdb\x89\xad\x3a\xdb\x2e\x8a\x78\xb5\xfc\x44\x58\xbd\x34\x1b\x17\x70\xd5\x86\xf5\xb0\x72\x65\x61\x32\x9a\xf7\x9c\xb7\x37\x8d\x2a\xbe\x5b\xdb\xd3\x0d\x2b\xa7\x78\xaa\xe8\x73\x73\xba\x49\xe0\xf3\x41\x42\x37\x4c\x9b\x19\xdf\x8b\x34\xbf\x8e\xd3\x69\x64\x35\x05\x60\x52\x15\xe5\x54\x1c\x79\xa8\x52\xcf\xa7\x34\x5e\x08\x31\xb5\x38\x75\xf1\x35\x4b\x8d\x9e\x97\x70\x31\xa7\x78\x39\x9f\x8b\x3e\x79\x87\x0c\x6e\x8c\x5a\x16\xac\x45\xd9\xf5\x75\x4b\x05\x5b\xc5\x45\xc9\x78\xa6\xe7\xef\xc2\x6a\x70\xfa\xf5\xfd\xf9\xf4\xfc\xe2\xcb\xfb\x5f\xde\x4d\x3f\x9e\x7e\xfe\x0c\xff\x9e\xc3\x2c\xb6\xe3\x61\x70\x09\xff\x6d\x47\xf8\x03\xff\x6c\x8f\xc6\xa3\xe0\x12\xfe\x40\x5b\x83\x18\xe6\x7e\x91\xbf\x87\xcd\xde\x4c\xf5\xca\x31\x7e\x07\xe0\xba\x66\x8b\x6e\x24\x2f\x00\x9e\xdc\xd2\xd5\x60\x19\x97\x9f\xee\xb3\xcf\x05\x50\x0b\x45\xb5\x85\x36\x24\x1a\x68\xa9\x00\xf7\x5f\xc8\xa1\xe5\xd7\xec\x5b\x96\xdf\x67\x1d\x1c\x4c\x67\xdc\xe9\xf6\xd8\x0e\xbb\xcf\xd6\xb7\xd7\xac\xa8\x77\x8f\x88\x06\x6e\xbf\x11\xfe\x23\x7b\x60\xa2\x21\x01\x39\x25\xdc\x29\xe9\xb6\x53\xae\x57\xab\xbc\xa8\xca\x4e\x75\x9f\x77\xb6\xfd\x18\x76\x3d\x00\xa0\x84\xa9\x02\x6d\x73\x53\xe4\xb7\x9d\x61\x7f\x04\x70\x07\x8d\x32\xbf\x6d\x24\xa1\xe8\x02\x51\xb4\x71\x04\xac\x0d\x30\x41\x94\x5f\x56\x7a\x23\xf9\x9e\xc3\xfd\x4f\xbd\x3c\x58\x70\xc0\x06\x25\xd2\x52\xde\xc8\xb7\x01\xf3\x81\xc3\xe0\x18\xe0\x24\xe7\x87\x60\xfc\xb0\xdb\xd5\xc0\xd5\xc8\xa9\x03\x2e\x1d\x84\x38\xda\xd3\xd0\x29\x32\x71\x10\xb1\xff\x60\x60\x61\xb8\x3a\x87\x61\xf1\xda\x1c\x96\x44\xaf\x85\x44\xaf\xe2\x3e\x15\xd9\x93\xe2\x4a\xde\xa9\xd4\xf4\x24\xbb\x22\xb8\x2c\xa1\xb1\x14\xc8\x18\x5c\xba\xb0\x9b\x5f\xff\x4f\x06\x47\x52\xed\x5d\xba\xbf\xef\x95\x51\xaf\x97\x98\x33\x9d\x94\x57\x91\x9a\x6b\xa6\x27\x9b\x02\x65\x93\x3e\x3e\x9a\x25\x87\x57\x62\x0a\xfc\x3e\xca\x7c\x6b\xce\x50\x39\x7a\x48\xe6\x9b\x71\x11\x6c\x71\x79\xc6\xa5\x6a\x2b\xdf\xed\xe4\x74\xff\x95\xa9\xea\x8e\x06\xa2\x65\x3d\x59\xd7\x51\x49\x09\x56\xf7\xcc\xba\xf5\x03\x92\xfa\xd6\x3d\x29\xe8\xba\x01\x51\xb5\x5e\x97\x43\x16\x40\x60\xd6\xeb\x76\xee\xe3\x0c\xc1\x38\xef\x94\xcb\x18\x50\x67\x4c\xd0\x0c\x10\x7a\x9f\x54\xcb\x4e\x29\x4b\xa6\xbd\x6e\xd0\xb9\x5f\x26\xb3\x65\x67\x9e\x43\x4a\x96\x57\x1d\x4e\xc4\x01\x7e\x85\x33\x21\xe0\x7a\xd0\xe5\x68\xb6\xb4\x67\x96\x5e\x0d\x68\xed\xc2\xfa\x7c\x29\x35\x2a\x6b\x1b\x57\xdb\x8e\xdd\x6e\x07\x2b\xc6\x3a\xff\x19\x6b\x6d\x42\xac\x86\x2e\x1b\x57\x79\x7c\xed\xfd\xf0\xc5\x60\x50\x1b\xff\xc9\x93\xd3\x19\xbf\x00\x4a\xe1\xe0\xed\x70\x1e\x4b\x73\xd4\x88\x64\x70\xc0\xa1\x40\x45\x83\xf5\x6a\x8e\xa4\x41\x0d\x96\x25\x0c\x2d\x07\x5b\x4e\xc8\x1b\xf9\x62\xa1\x8e\x47\xfb\xfb\x4f\x34\x32\xb2\x1a\x39\xe2\xad\xb8\xca\x73\x7c\x61\x14\xde\x50\xd9\x9d\x8d\xcf\x00\x57\x59\x17\xa7\x8d\xc6\xde\xd1\x1d\xf8\x2b\xce\x10\x32\x25\x4a\xcc\xd6\x69\xba\x07\x58\xf8\x84\x8d\xed\x82\x67\xec\x26\x5e\xa7\x15\x96\x6d\xf4\x62\x34\x65\x75\x28\xef\x0a\xbd\x94\xb5\xe3\x52\xf9\x27\x06\xd2\xa9\xae\xc6\xd8\x7d\x4b\xf3\x72\x00\xad\x3d\x88\x4b\xbd\xad\x0f\x91\x8d\xbd\xc8\x25\x3d\x7b\xfb\xd3\xe9\xaf\x1f\x2e\xa6\xa7\x17\x17\x5f\xce\x1d\xf5\x9c\xe5\x5a\x87\xf9\x53\x5e\xd0\xa9\x6a\x12\xe0\xc0\x27\x87\xee\xcb\x2f\x8e\x18\x70\x3e\x44\x67\x9f\x74\xb7\xdd\x71\x77\x7b\xd4\x0d\xf1\xd0\xd1\x5d\xbd\x1d\x41\x59\x06\x58\x96\x45\x90\xe9\x07\xf0\x07\x2f\xc8\x18\xce\x21\x1d\x4c\x2a\x73\x24\xd2\x46\xba\xde\x06\xf8\x3b\xe7\xed\x87\x77\x5f\x1c\xf5\x47\xbb\x24\x62\x3b\x7e\xa4\xfb\x23\xec\xdb\x80\x2a\xeb\x98\xc4\x57\x38\xf0\x42\x1e\xee\x42\x82\x1c\xa6\x66\xcd\x15\x93\xc8\x2f\x83\x45\xda\xc9\x91\xe0\xcd\xde\x05\x26\xbd\x04\xba\x94\xe1\xb7\x00\xc5\xbc\x0d\x14\xb1\x6d\x0e\x86\xb9\x6c\x30\xdf\x71\xec\xe6\xde\x39\x3c\xa0\xc0\x5f\x48\x18\x2e\x1d\x5b\x59\xe2\xb6\xbd\x1c\xa2\x61\x2f\xeb\x64\x00\xed\x26\x5e\xf8\xb0\x59\x36\x5d\x08\xe5\x7f\xb6\x49\x3b\xcf\xdf\xdf\x77\x52\x83\xed\x4b\xe6\x2c\x0e\x83\x0e\x9f\xbb\x72\x80\x26\xb3\x77\x5a\xa0\x35\xda\x6c\x4e\x34\x58\xf8\x94\x21\xaf\x1d\xab\x8d\x14\xdd\x27\x8e\x35\x4b\xcc\x35\x13\xd0\x0d\xcb\x10\xf3\xdb\xa3\xb6\x68\x00\xdb\x08\x32\x0e\xca\xbe\x89\x06\xed\x9a\x08\x99\xae\xf5\xb6\x1b\x28\xd5\x8a\xf0\xee\x9b\x6d\x34\x78\x8b\xca\x35\x04\x6c\x40\xcc\xdd\x6e\x82\x77\xd0\x3c\xc4\x6d\x8d\xf0\xf2\xae\x36\x7e\x89\x6f\xdb\x17\x42\x5c\x8d\x3b\xb9\xf0\x15\x70\x48\xf0\x7f\x89\xe6\x3f\xc4\xdb\x7c\x5d\x45\xff\x22\x37\xb4\xca\x13\x20\x2e\x14\x4f\x52\xb2\x8a\x8f\x49\x26\xc4\x59\x86\xdc\x2d\x2d\x97\x4c\x13\x84\x2a\x9e\x3c\x41\x94\x5e\x24\xb3\x6f\x56\xca\xd6\x48\xd1\x13\x30\xb7\x61\x3e\x3f\x03\x66\xbb\xb4\x2e\x9e\x40\x92\xc8\x7c\x54\xfc\x3a\x66\xbe\x3d\x34\x9e\xea\x38\x89\x9f\xd3\xbc\x3a\x2d\x58\xdc\xb6\x9e\x40\x3b\xc5\x53\xbb\xd6\x2c\xbf\x5d\xad\x2b\xe6\xaa\xc9\xd7\xef\x61\x83\x2c\xd3\x78\x88\x2c\xd6\x7d\xed\x30\xdf\x27\xf3\x6a\x39\xed\x57\x83\x4d\xbf\x71\xca\x39\x84\x79\x5d\x12\xbe\xbe\x8b\x57\x80\x92\xab\xc1\xb2\xd6\xc0\x92\x61\xee\x54\x48\xb8\x1e\x66\x40\xdd\x55\xd3\x79\x72\x37\xae\x35\x87\xff\x9c\x25\x77\x01\x70\xf0\xac\xb8\x63\xe7\xab\x78\xc6\x3e\xb0\x9b\x6a\x6c\x72\x6c\xfc\xfc\xc2\x78\x61\x3c\x30\xe2\x6a\xb0\x0d\xee\xc7\x2c\x58\xc2\xaf\xa5\xb1\x03\x9b\x1e\xdc\x23\x30\x97\x3e\xfc\x13\xef\xac\x26\xbf\xe0\x68\x5a\xdb\xec\x61\x25\xf6\x44\xcb\xae\x26\x2f\xf2\xd5\xf3\x83\x84\xaa\xd0\x18\x33\x9a\xda\xf2\x41\x2e\x1d\x2d\xfe\x98\x57\x55\x7e\xfb\x4c\xa3\x3d\xac\xcb\x9c\x4d\xcb\x36\x69\xb1\xbf\x00\x93\x32\x6e\x40\x8b\x73\x78\x38\xd7\xdd\x2e\xb4\x77\x66\x16\xc3\x4d\x35\x67\x6f\xef\x18\x40\xeb\x14\xb9\x3d\x3c\x92\xdd\x40\x82\x2c\x41\x5c\x54\xd5\x4f\x7e\x75\x6a\x1c\xaa\xc6\x09\xb5\x4f\x9c\x96\xa1\x47\xad\x40\xb6\xf9\x2d\x4e\xd7\xec\x33\x72\xa4\x05\x8a\x09\x5c\x18\x69\x17\x20\x2d\x10\xbf\x96\x32\xa1\x30\x96\x84\x38\x0a\xe6\xf9\xd5\x01\x77\xf8\x60\x73\x17\x03\xb3\xc6\xc5\x92\x78\x7d\x51\xda\x53\x9c\x8a\x31\x97\xce\x2d\xca\x7d\x96\xf1\x1d\xf0\x28\x59\xe7\xd5\xe6\x55\x67\x25\xee\x08\xce\x00\x53\x63\x09\xd4\x07\x3a\x85\xf7\x56\xbb\x49\xba\x74\xa4\x70\x0a\xee\x6c\x7e\x60\xba\xfe\x93\xac\x13\x09\x9f\x60\x91\x3b\xd4\x18\x8c\x64\xde\xe1\xf5\x80\x39\x62\x78\xe7\x55\x15\x49\x9f\xd5\xb8\x69\x48\xe6\x50\x6b\x74\x34\x30\xad\x30\x18\x3f\x48\x68\x71\x1e\x1f\x3d\xfe\x23\x62\xf8\xc3\xf7\x1b\x78\x92\x23\xa8\xa4\x4e\x63\x43\xc7\x5f\x09\x2b\x36\xb7\x5c\xe2\xcf\x26\xa4\x5c\xda\x37\xa5\xac\x20\x30\x70\xad\x3c\x83\x51\xad\x61\xc4\x0d\x81\xf6\x94\xe8\x35\x25\xd3\x9a\xca\x82\x1f\x92\x5b\x60\x0d\x3d\xbf\x91\x9c\xb1\x37\x78\x3e\xdc\x59\x34\xd8\x46\x8e\x01\x07\x5e\x0d\x37\xd7\xfa\x6b\x62\x59\x1b\xb2\x69\xb0\x5f\xe2\x6c\x81\xc2\x5b\x63\xfc\x83\xdb\x24\xc3\x75\xaf\x50\xb4\x6a\xa5\xc7\x1b\x9e\x3e\xba\x12\xb8\x14\x7f\xf6\xb1\x9c\x55\x9f\xc8\xca\x68\x48\x4c\xcb\xe8\x90\x8d\x47\x41\xcb\x91\x92\x14\x8c\xa6\x45\x03\xa0\x4f\x81\x52\xf3\xcc\xe6\x36\x90\x5b\xe0\x30\x15\xad\x09\x09\xa3\xa1\xd7\x1c\x9a\xdf\x7f\xa2\x04\x4d\xca\x0f\xea\x2d\xeb\xc1\x3a\xfb\x84\x09\x38\xd3\xc7\x23\x2d\x85\x16\x27\xbe\x41\x54\x99\x67\xbf\x4e\xc2\xe3\x78\xb6\xb8\x9a\x89\xbc\x1c\xe7\x84\x5d\x68\x3f\x70\xe1\x13\x9c\x53\x7b\x89\x11\x96\xd8\xf2\x65\x51\x45\xfb\xaa\x59\xcc\xd4\x33\x93\x25\x61\x32\xf2\x67\xfb\xa6\x18\xbb\x81\x3b\x01\x15\x5a\xd6\x5f\x75\x5b\x5f\x76\x35\x0a\x3c\xcb\x5b\x6b\x8d\x8d\xd6\xf8\x60\xf4\x7a\x06\xde\x1e\x50\x9d\x49\x96\x20\x2a\xd0\x19\x80\xa4\x12\xa0\xdf\x7e\xb1\xd2\x60\x60\x35\x6c\x24\x98\xa9\xb8\xd7\xed\x00\x1b\x47\xa3\xe9\xc4\x15\xa4\x24\x83\x05\xa4\xcd\xe2\xec\x55\xd5\xb9\x66\x9d\x79\x52\xae\xe0\xfa\x60\xf3\x4e\x92\x75\xa0\xbd\x0e\x8d\x0d\x05\x2a\x1d\x6a\xba\x33\xc1\x2a\x62\xfc\x50\xb1\x4f\x4d\x88\x89\xf6\xba\x57\x80\x14\x09\xdf\x40\xad\xd9\xd7\x5f\xf2\xe2\x16\x85\xd6\x26\x65\x15\xc4\xea\x32\x88\x4f\xbc\x1a\x3c\xd6\x57\x8a\x49\xb0\xf4\x0f\x98\x86\xc6\xb1\x57\xf5\x55\x0e\x64\x50\xa2\xec\xf4\xb2\xad\x53\x2e\x74\xe4\xd0\x36\xea\xd7\x7a\x66\x8d\xa3\xa1\xf6\xc8\x3f\xa8\xf4\x26\x29\xe6\x43\xed\x04\x30\x1d\xe3\xe1\xe1\x70\x27\x32\xa0\x65\xd6\xd7\x95\xb1\xae\x1a\x9e\x13\x13\x49\x14\x67\x62\xa3\x17\xdc\xb5\x65\x15\xcf\xbe\xb1\xf9\x3b\xcc\xe8\xe2\xab\xc9\x1f\x43\x21\x01\x7f\xfa\x32\x89\x5c\xe3\x11\xec\x41\xcb\xcf\x8c\x12\xf8\x1e\x96\x45\x16\x0d\x8c\x49\x79\xfb\x20\x01\x06\x33\x20\x6d\xce\x51\x4a\x0d\x93\x9d\x7f\xa6\x76\xba\x41\xe6\x07\x65\xfd\x25\x03\x06\x29\x2e\x58\xce\xd3\x08\xb6\x34\xc3\x67\xa2\xe6\xa3\x47\x72\x0d\xe7\xbd\x9c\x5a\x8c\xaf\x39\x47\xa8\xb6\x84\x19\x2e\x5f\x17\x72\x5e\x4b\x89\x6b\x56\x51\x31\x59\x5e\x85\xab\xc1\x26\xb2\xc1\xd4\x5b\xd1\x7d\x6a\x62\x40\x80\x9b\x90\x3f\xed\xad\x06\xb8\x9f\x21\x8a\x36\xe0\xe7\x54\x2c\x7f\x64\xc3\x9c\x57\x06\xbc\x9c\xcc\x0f\xe0\x88\x23\xeb\x01\x14\xcc\x42\x9e\xd3\x05\x9c\x58\x4f\x36\x28\x0b\xfa\xba\x1c\xf4\xb0\x88\x00\xa2\x82\x1c\xca\xf1\x52\xf8\x09\x25\xe0\xa3\xd9\xe1\x02\xfa\xd8\xd9\x0b\x04\xd4\x42\xfc\x33\xd0\x1b\x29\xca\xaf\xf2\x0c\x61\xec\xad\x80\xb7\xb9\x57\x04\x25\xd6\x78\x02\x1e\x6b\xc4\x81\xb8\x1c\x03\x52\x9e\x20\x9c\xce\x69\x85\x8a\x8a\x21\x2f\x06\x0b\x5d\xbd\x36\x09\x08\xb9\xe6\x15\xac\xb9\x80\x61\x91\x03\x6c\x28\xc0\x2a\xe3\x3c\xa5\x7c\x41\x57\x03\xaf\xf2\xcf\xac\x98\x01\x11\xfb\xf5\x4d\x9e\x17\x73\x38\xfe\x77\x00\xa9\xc7\xd1\x70\x7f\x7f\x74\x9c\x08\x01\x05\xef\x58\x3c\x08\x27\x01\x50\x44\x7a\x50\x5b\xd7\xa0\xec\x3b\xa7\x32\x1e\x79\x8b\xc8\xe6\x90\x01\xbc\x87\x61\x06\x30\xc3\xbb\x10\x35\x32\x9a\x85\x48\x44\xd1\xeb\x0b\x26\x70\xa9\x26\x10\x54\x38\x05\x9a\x41\x24\xa7\xb0\x35\xa7\x50\x05\x34\x89\x96\x0d\x71\x92\xe8\x6a\x4b\x90\x64\xa6\xc9\xeb\xd9\x9a\x04\xa0\x31\xe5\x07\x43\xae\x62\x14\x41\x71\x0d\x9b\xc4\x04\xf8\x3d\x40\x0d\xbd\x58\x48\x04\xae\xa2\x18\xc5\x61\x66\x79\x36\x9f\xd6\xb8\x74\xa3\x21\x31\xb3\x66\xef\xbe\xfd\xd8\xde\x86\x71\x5c\xd8\xc6\x98\x5a\xd1\x98\x4b\x06\xc7\xb8\x42\xec\x93\xe9\xc1\x67\x83\x0c\xb0\x52\x98\xe3\xdd\x85\x27\x35\x33\xc6\x13\xb1\x49\x6e\x0f\x5b\xcd\x47\x8a\xdb\xf1\xf9\xa0\xf6\xbc\x86\xda\x0d\xa7\x69\x2a\x44\x04\xd6\x16\xcc\x59\xca\x2a\xd6\x31\x86\x1c\x98\x49\x12\x45\xd6\x13\x39\x0e\xfc\x40\xb3\x69\xc9\xfc\x74\x73\x83\x9d\xbd\x4c\x30\x52\x6f\xb2\x99\x21\x9a\xc3\x77\xf5\xa0\x32\x04\x37\xe6\x13\x3b\x2b\x5e\x2c\xc0\xe1\x88\x80\xbf\xc1\xba\x24\x39\x9c\xf9\x94\x2a\x2b\x30\xbf\x5b\x38\x0f\xc8\x47\x1b\x9f\xe2\x39\x3f\x8a\xc5\x0b\x34\xf1\x45\x80\xdb\x84\x24\x82\xa7\x12\xdf\x04\x89\x5c\xbe\x11\xec\x09\xdd\x95\xf7\x6f\xf7\xf7\xf7\xe4\x65\x9d\x94\x7c\x16\xe7\xfc\x91\x15\xf0\x9b\xd9\x8d\x94\x33\xf2\x32\x70\x69\xd3\x63\x54\x29\xcb\x0e\xba\xa1\x02\x71\x94\xba\x24\xa6\xf4\x46\x32\x16\xea\x6c\x27\xe5\xaf\x25\x30\x6c\x6f\x37\x33\x6a\x6d\xea\xf3\x7b\x62\x06\x65\x2b\xf6\xfe\xed\x9b\x34\x59\xf1\x7a\x4a\xd2\x6d\x8c\xf2\x34\x9b\x17\xc0\x23\x7a\xbe\x05\xe9\x06\x03\x4f\x4d\xce\xaa\xcd\x34\x2c\xb4\xfe\x0d\x8c\xa1\x00\x20\x9c\x55\x7a\x90\x83\x8d\x66\xe9\x07\x5b\xe3\xf7\xbd\xf1\x7b\x89\xf5\x66\x30\x20\x6c\xa0\x2e\xec\x49\xe6\x73\x96\x51\x57\xc1\x7f\x6e\x57\x3b\xa7\x80\x8d\x74\xe7\x1a\xa8\x4b\x2d\x3c\xee\xa7\x5f\x15\x5b\xce\x20\x52\xe1\x33\x06\x20\x24\x79\x1c\x9d\x82\x8b\x34\x63\xa9\xdc\x17\x9d\x41\xc2\x3d\x48\x8d\x1c\x10\xb6\x9b\xc5\xd5\x0c\x65\x77\x82\xe6\xdc\x39\x4b\x21\xe9\x88\xcd\xa1\x1c\xc6\x1b\x06\x43\x03\xfe\x4c\x00\xf5\xeb\xd8\x01\x4f\x4e\x93\xc9\xe5\xec\x3a\x3f\x7b\x8a\x3d\xe5\x85\x15\xb9\xd7\x60\x4d\xeb\x60\x64\x91\x83\xe2\x67\xa7\x12\x6a\x71\xc4\x8d\x0d\xee\xf7\xf7\xf9\x8f\xa5\x24\x6b\xe7\xf9\x6c\x8d\xd3\x1a\xf0\xd6\xde\xf2\x49\x7a\xdd\x79\x72\x87\x92\x0f\x98\x64\x5c\x92\xac\x17\xdf\x70\x06\x65\xb5\x05\xfe\xe0\x1a\xe8\x0f\xd4\x4e\xcc\xe6\x6f\xf2\x34\x2f\xa2\x42\xe5\xac\xf2\x32\x21\xcc\xd9\x8d\xaf\x81\x95\x00\x82\xab\xab\xf2\x52\x76\x83\xe7\x75\xd3\xeb\xae\x36\x3a\xb5\xca\x57\x11\x8a\xbd\xac\x44\x75\x8c\xed\x64\x7d\xe6\x79\x7a\x3c\x88\x57\x2b\x58\xa4\x37\xcb\x24\x9d\x93\xd4\x42\x53\xc1\x5d\x01\xbd\x7d\x04\xb4\x3e\xce\xa6\x49\xf0\x4a\xe1\x24\xbe\x14\xcc\xb0\x8d\x5f\xf2\x39\x2b\x95\x0e\x59\x88\x64\x44\x98\xf4\xfb\xbe\x99\x0d\x97\x8c\xb1\x28\xf8\x70\x15\x0b\xa4\xcf\x87\x51\x2b\xab\x59\xda\x42\xaf\xf5\xf5\x82\x16\x4e\x92\xc6\x8d\x11\x85\xd9\x9e\x2a\x1c\xca\x77\xa4\x6c\x30\x5b\x17\x00\x13\xd5\xb9\x6b\x13\xf0\x74\xe4\xfb\xfb\x5d\xd2\xfa\x02\xda\x19\xca\x75\xf7\xa2\x1c\x80\x38\xca\xc3\x6b\xd8\xdb\x6f\xbb\x0c\xf5\x38\x29\x07\x07\xb7\x33\x5e\xd2\xf1\x58\x86\x95\x27\x25\xc6\xc1\xfd\x18\x68\x26\x94\x1c\x6a\x40\xc6\x97\x5e\x2c\x50\x92\x78\x11\x8b\x68\x80\xef\xf3\xd2\x40\xa4\x18\xa5\x7a\x90\xe9\x2c\xd9\x2f\xb9\x54\xb2\xad\x6d\x68\x06\xea\x2e\x5d\x3d\x18\x75\x20\x05\x53\xa1\x47\x3c\x15\x53\xc0\xc2\xef\x2b\x06\x1c\x43\x5e\x7c\x2e\xd8\x3c\x99\x59\xb2\x24\x75\x96\x3b\xec\x04\x0a\xaf\x64\x89\x8b\x65\x5c\x9d\x7f\x4b\x56\xe5\xdb\xdb\x55\xb5\xe5\xc7\x4f\x3d\x7b\x3e\x5d\xac\x26\xfa\xb7\x1f\x96\x51\x43\x96\xa8\x70\x6a\x67\x5e\xc4\xf7\xb4\x67\x73\x3c\xcb\xc6\xa8\xf0\x52\x0c\x00\x0a\x82\x3c\x28\xf9\x2e\xa7\x40\x26\x0a\x68\x00\x66\x24\xc5\xcb\xe5\xc7\x1c\x58\xf2\x38\xd3\x4c\x1c\x5b\xe1\x7d\xd3\x0d\x98\xbc\xd8\xb5\xd4\x0f\x2e\x8d\xa2\x88\xb7\x1f\x92\x6f\xcc\x2b\x90\x67\x28\x38\xa2\x0b\x39\x13\xe3\x6a\x0f\x07\xf7\x2e\x5e\xbd\x9d\x2f\x98\x64\xb5\x74\xc3\xc1\x22\x62\x8a\x50\x89\x54\x7a\xb0\x56\x62\x0b\x81\x83\xc4\xd2\x7b\x0b\x40\x84\x0b\xa9\xf8\xd7\xb2\x2d\x9e\x6b\x18\x6d\x6c\x1f\x70\x39\x7e\x30\x8b\x8a\xfd\x7d\x49\xce\x1d\x47\x47\xc1\x16\x97\x09\x06\xae\xb5\xfc\xc2\xad\x50\x99\x0c\x66\xfb\xfb\xdb\x9a\x8a\x30\xac\x05\xad\x00\xd0\x1b\x62\x37\x38\xf3\x07\x13\x81\x0d\x28\x61\x03\x56\xc1\x12\xf9\x36\x91\x2d\x68\x20\xe2\x82\xa0\xd0\x14\xf6\x27\x86\x1d\xe2\x4d\xd7\x55\x08\xa1\xcb\xad\x56\x50\xd4\x1b\xde\x7c\x58\x75\x6c\x37\x74\xbb\xe2\x4f\x4f\x0b\xfe\xcf\x9c\xff\xb3\x46\x92\x6c\x16\xed\xc1\x71\x84\x31\x37\xa6\x6a\x5e\xc1\x5b\xa1\xd5\x4a\x10\x16\x95\xf0\x8d\x3a\x8b\xff\x41\x18\x34\x56\xc8\x07\x55\xb6\x62\x84\x8d\x69\x70\x07\x3f\x01\x69\x4e\x83\x1b\xdc\x5c\xb9\x2b\xd3\x60\x83\x1b\x5c\xe1\x3b\x4e\x78\x77\xbc\x09\x37\x48\x35\x03\x52\x59\x46\xd3\xc9\xe6\x2a\xb8\xe1\x1c\x3e\x66\x01\x2d\x75\xe3\x41\x79\x3f\xf4\xa1\x10\x22\x9e\x4d\x14\xdd\xf9\x84\x64\x42\x5e\x7c\x27\x5e\xb7\x81\x39\x5d\x0a\x4a\x65\xfb\xf8\xa8\x7e\xee\xe9\x54\x1f\x90\x96\x38\x35\x2b\xb8\xc0\xb7\x42\x39\xd8\x5b\x05\x0b\x5f\xcc\x05\xbe\x64\xf1\x0d\xa4\x02\x53\x1b\xf1\xd5\x52\xba\x00\x69\xb4\x37\x0a\xb2\xc7\xc7\xbd\x95\xff\xc0\x06\x19\x2c\xd2\xfb\xf9\x66\x1a\x6d\x02\xfe\x01\xab\x84\xd0\xbb\x8c\xcb\x5f\xe0\xeb\x04\x66\xcd\xd8\x37\x39\x00\x3a\xef\x04\x1e\xb7\x91\x18\xf2\xfc\xf1\x71\x0e\x08\x38\x84\x66\x61\x4c\xb7\x01\x09\xfd\x61\xf3\xf7\x56\x8f\x8f\xaa\x19\xc8\x40\x59\x1e\x94\x19\xfa\x3b\x39\x83\x93\x18\x92\xf2\x97\xce\xc3\x91\xac\xd7\xc5\x1f\xab\x36\x5c\xd9\x81\x57\x3c\x3e\xa6\x30\x82\xb5\xe0\x09\x1d\x85\xe0\x57\x32\xdf\x5c\xe1\x82\x19\x1d\xeb\xb5\xdf\x01\x84\x8d\xa4\x14\x4a\xc2\x11\x2c\xd6\x5a\x41\xb1\x79\x12\x1c\xb0\xac\xa5\x3e\x59\x03\x4c\x81\xcd\x1a\x86\xf9\x6b\x26\xb9\xb0\x5c\x72\x61\x25\xb1\x55\x61\x26\x0f\x6c\x2c\x54\x79\x15\xe2\x33\x7f\x49\x8c\x93\x05\xa8\x4a\x0d\x7f\x50\x48\x0b\x87\xa8\x9c\x1c\x91\x8e\xb8\x3e\x78\x35\xe2\xc8\xa4\xa8\x5c\x72\xb2\x2a\x32\x38\x0f\x89\xe3\x58\xa4\xde\x98\x58\xbf\x1f\xfa\x5a\x1a\x5d\x49\x8d\x02\xc9\x80\x62\xb6\xa0\xcb\x63\xd2\xca\x90\x0b\x1c\x19\x44\xef\x41\x81\x8f\x8f\x06\x71\x2c\x4b\x6d\x8d\x52\x4b\x28\xb5\x35\x4a\x6d\xeb\x53\xa9\xd1\x81\x6e\xd5\x1b\x20\xda\x99\x50\x3c\xac\x11\xaa\x99\x6b\xa6\xb9\x95\x28\x39\xc4\x50\xd8\x69\x00\x3d\x51\x17\xb6\xf1\xc4\x8f\xf1\x6a\x6a\xaa\xe0\xb9\x24\x78\x2b\xb8\x9d\x2a\x7c\xcd\x43\x4d\x78\xe7\xd5\x94\xe2\xd5\x94\x46\x93\xf4\x8a\xe3\xe5\xa5\x94\x49\x70\xa9\x7f\x6e\xc9\xfa\x93\x28\x47\xe9\x3e\xbf\xc1\x9e\xed\x14\xb8\xcc\x70\xb5\x17\x95\x70\x04\x97\xb0\x2d\xd1\xca\xdf\xe9\x86\x53\xb3\x61\xb9\xb7\x8b\x28\x85\xf6\x01\x41\xc4\x51\x94\x2a\xda\x0f\x30\xf0\x30\x5c\xbf\xce\x64\x8d\x35\xd4\x00\x44\x83\x83\x59\x5f\x01\x5f\xf9\xf8\x98\x28\x15\x9f\x59\x94\x61\xe2\x36\x5a\x20\x2e\x4c\x50\x92\xb0\xe4\x4a\x94\x73\x1f\xee\xb5\x2a\xc9\xd6\x2c\xdc\x46\x38\x9e\x9d\xb8\x86\xf4\x32\xa3\x38\xf4\xee\x29\x99\x2d\x9e\x49\xc2\xe6\x34\xb7\x42\x9e\x9a\xc2\x42\xfb\xc8\x97\x69\xb4\x7f\x17\x6c\xbd\x07\xbe\xd1\xe3\x59\x20\x36\x77\x9c\x04\xf6\x01\x1d\xa3\x79\x12\x0c\x61\x3c\x0d\x8c\x5e\xc6\x77\x81\x18\x87\xfd\x94\x1f\xa0\xb4\x75\xfc\x32\x01\x6c\x02\x18\x47\xb0\xc4\x63\x05\xd7\x01\x17\x12\x91\x12\xe4\x78\x2d\xbe\xde\xa0\x7d\xd4\x58\xae\x72\x80\xcc\x72\x2a\x54\x93\x69\xcc\x55\x00\xa8\x81\x7f\x4b\xea\x6c\xc7\xb9\x4e\xf3\xd8\x4f\x3f\xf3\xdd\x2f\xa3\x07\x5c\x04\xf1\x65\x3d\xb4\x43\x21\x23\xcb\x43\x73\x92\x9b\x04\xee\x70\x77\x51\x23\x8b\x8a\xd2\x83\x48\x4b\x59\x33\x0f\x0b\xd3\x80\x8c\xbe\xa2\xe6\x7b\xbf\xa6\xf1\x12\x83\xa8\x2a\xe8\xfe\x55\x1b\x01\xe7\x36\x46\x48\xf8\x05\xb8\x80\x22\x99\xd9\x00\xf1\x63\x5e\x00\x4a\x50\x60\x01\xc7\x39\x36\xa1\x46\xa1\xee\x37\xb0\x7c\xc8\x28\x60\xa1\xc7\x47\x79\x16\xdf\x24\x05\xf0\xae\xa5\xd4\x2f\x0b\x4a\xbb\x36\xef\x42\x90\x37\xd4\x7c\xca\x0b\x38\x48\x47\x49\xa7\x25\x28\x3c\x77\x8d\x97\xc0\xf0\x3c\xf9\x9d\x11\xf8\xc2\x4d\x0a\xa4\x5c\x83\x26\x46\x52\xb8\x39\x02\x3e\x49\xe2\x6e\xa8\x83\xa2\x77\x74\x90\xa1\x28\x1a\x08\xa8\xa5\x1f\xb8\x5a\x61\xfc\x54\x05\x85\x2a\x46\xdb\x61\xee\xd1\x1f\xd8\x0f\xd7\xa4\xa9\xa9\x1f\xe3\x82\x8c\x1a\x5c\x05\x66\x6b\x00\xcd\x5b\x5e\xc2\x52\x2b\x74\x15\x46\x40\xe3\xef\x31\x62\x75\xe4\x13\x1c\x1a\xe1\x79\x28\x84\x7a\x55\x75\x50\xb2\xa6\x0a\x76\xb8\x5a\x17\x57\xa0\xa6\xc1\x74\xae\x79\x5f\xc4\x25\xc2\x5e\xd6\x49\xe3\x00\x99\x0a\xbe\x2c\xee\x2d\xc2\xb6\x4f\xd3\xd5\x32\xa6\x55\x5e\x39\x07\xaa\xd9\x8e\xa4\xc6\x15\xfc\x2b\x9c\x40\xfc\x07\x38\x81\x04\x39\x81\x35\xbd\x72\xcc\xe8\xef\x36\x9a\x00\x9e\xee\x03\x41\x30\x55\x83\xa8\xf2\x2f\xef\x7e\x9c\xc2\x05\x03\x38\xb5\x5b\x2c\xae\x63\xaf\xdb\x9b\x0e\x0a\x92\xf4\x4e\xf1\xd5\x92\xfe\xbd\xa6\x7f\x97\xbd\xae\xdf\x0d\xcb\x01\x4e\x9f\x63\xd1\xbb\xa0\x34\x49\x6b\x45\x3c\xdf\xb8\x14\xe5\x04\xad\x08\x37\x81\xd6\x38\x91\xef\x35\x95\xbf\x0b\xe7\x92\x50\x54\x26\x54\x73\x41\x8c\x86\x48\x55\x02\xd1\x3c\xd8\x02\x08\xc1\xcf\x3d\x5e\x69\xe6\x63\xea\xcc\x3f\xa1\x49\x8e\xbd\x3c\x9a\x40\x91\xe9\x35\x69\x0d\x05\xf8\xb3\xca\x57\x57\x01\x52\xc9\xb3\x08\x2b\x07\xbc\x5e\x0e\x84\x11\x52\xa2\xf8\xaf\x9d\x3e\x12\xe9\x23\x91\x4e\x25\x60\xeb\x04\x6e\x06\xca\x03\x53\x7a\x46\xca\x36\xa0\xd2\xb5\x32\xa3\x5a\x19\xde\xfe\x1a\x6f\xf1\xd5\x89\x27\xed\xf9\xbc\x75\xb0\xc5\xa1\x04\xd2\x48\x0f\x66\x28\x89\xce\x27\x32\x60\x90\xfe\xf8\x45\x8d\xe4\xed\x19\xd8\x88\xce\x59\xf3\x66\x03\x94\xf8\xe5\x25\xe3\xbb\x09\xe4\x76\x94\x03\x00\xa9\x6a\xfe\x8e\xef\xbd\xbc\x44\x6e\xe2\xb2\xe2\x52\x5f\xb8\xd3\x36\x5b\x87\x52\xf9\x04\xdf\x71\x88\x3f\x4b\xa2\x11\xe0\x87\x23\x7c\xfc\x09\xf2\xba\x05\xea\x9e\x27\x89\xde\xd7\xd1\xc8\xd7\x24\x27\xbd\x01\x49\xd9\x52\x7c\x0c\x14\x49\xbf\x2f\x91\x03\x13\x4a\xc8\x19\x6e\x51\x54\x48\x99\x0f\x24\xa3\x2d\x23\x6d\x0a\x50\x19\xa3\xab\xfd\xfd\x1c\x28\x5f\xfc\x7d\x04\xbf\x19\x99\xe9\xce\xe0\x20\x05\x23\x43\xfa\xc5\xa9\x1d\xa3\x33\xab\x17\xd1\x05\xd4\x9e\xc4\xbd\xd1\x15\xff\x3a\xb1\x9a\x1a\x03\x81\x44\xc6\x45\x92\xe7\x3e\x02\x28\xad\x24\xf9\x3e\x0c\x19\x37\x70\xa4\x56\x4a\x60\x01\x65\xc7\x29\x5f\x9d\x25\xff\x27\x06\xf2\x4f\x0f\x43\x6b\x6e\xd4\x67\x9b\xf8\x9a\x5b\x4c\x39\x37\x88\x7c\xa3\x0f\xb7\x0e\x62\x2c\xce\xe5\x71\xfa\x0f\xa7\x1d\xae\x5e\x33\x20\x1d\xe1\xd7\x09\x94\x18\xaf\x8e\xd9\x64\x79\x45\xcb\x01\xbc\x6a\xec\x73\xd3\x06\x40\x51\x50\x06\xd9\x3e\x7c\xb8\x55\x93\x03\x12\x5f\xae\x0a\xf0\xf3\xcb\xe3\xf4\x04\xe6\x48\xfc\x13\x70\x69\xe2\xd7\x1c\xe0\x31\x3d\x5e\xaa\x9c\xb9\xca\x01\x9e\x6d\xac\x7e\xe2\xc3\x8f\xc1\x59\xc4\xfe\x43\x0e\x7f\x42\x6d\x0c\x3a\x24\xc4\x2b\x26\x9f\x1e\x97\x61\x29\x17\x60\x09\xa3\x2a\xaf\xc2\x25\x9f\xfd\x49\xa5\xd8\x40\xe4\x6e\x96\xc8\xd9\x8c\x97\x72\x79\x2b\xc5\x04\xaa\xcc\x5d\xd6\x53\x0d\x07\x8c\xde\x68\x52\x93\x29\x20\xc6\x8c\x2f\x34\x19\x86\x92\x90\x10\x88\xb2\x50\x2e\x72\xfc\xf8\x98\xed\xc1\x52\xa1\x65\x13\x32\x60\x51\xa6\xa6\x48\x4f\x8b\x05\xf4\x21\xe4\xf2\x0f\xbc\xf7\xb1\xcd\x73\xa4\x5e\x21\x6c\x72\xf9\xc8\x1b\xd9\x89\xc8\xe6\x17\xb9\xa9\xa7\x58\x7a\x7b\x68\x7b\xaa\x98\x4d\x4e\x87\xb9\x4a\x88\xb3\x19\x28\x8c\xec\x2a\x64\xa0\xeb\x5d\xa0\x4e\xbb\xab\xa4\x81\x0a\x76\xc1\x94\x0c\xf3\x9b\xfa\x93\x9d\x6c\x27\x90\x81\xa6\x00\xeb\xc6\x85\x7b\x00\x4d\x35\x3a\x75\x7f\x9f\x6c\xfb\x06\x06\x89\x6b\x1f\x7b\x4d\x5f\xd0\x95\xf7\x81\xd4\xa3\x3d\x5f\x5b\xd1\x15\x51\xa2\xcc\x8c\x50\xea\x5c\x90\xd4\xf9\x2e\x29\x93\xeb\x24\x4d\xaa\xad\xe7\x4f\x0a\x34\xba\x97\x80\x5c\xc0\x29\x0d\xf9\x1e\x3b\x99\x5b\x7c\x00\x4d\xcc\x07\x50\x54\x65\x79\x86\x00\x99\x54\x57\x52\x3b\x71\x6f\x28\xf6\x7f\x6f\x84\xb6\xad\x78\x3e\x15\xc3\x94\x23\x6d\x65\x5c\x11\x70\x46\xd9\xa0\x46\xa6\x93\xc4\x41\x40\xe8\xe2\x19\xa2\x03\x85\x33\x6e\x92\xc3\x52\x57\x59\xf3\x42\x44\x0e\x22\xd4\xce\x50\x27\x70\xdb\xf2\xc8\x28\x4f\x14\x6a\xf1\x04\x73\xe3\xf1\x3e\xd1\x48\x51\xad\xb3\x38\x2b\x09\xac\x70\xa8\x6a\x22\x6a\x0a\x10\xe3\xe2\xed\x30\x8d\x56\x50\x61\x8a\x15\xa6\xb2\xc2\x5d\x93\xd0\xba\x81\x36\xa6\x84\xd8\x9e\x59\xea\x1b\x5f\xd2\x04\x4f\xd3\x5a\x37\x7e\x70\x1b\xad\xa1\xcd\xe0\x0c\x4a\xb6\xb1\x5b\x50\xea\x34\x1a\xf5\xce\xa4\x06\xd1\xc1\x99\x50\x20\x0a\x87\xc7\xa7\x27\xa7\xd1\x70\x7c\x8a\x26\x59\x1e\x14\xc2\x92\x29\xdc\xe9\xa7\xbd\x74\xb0\x25\xf8\xb9\x0f\xae\x81\x33\x85\x1e\x2e\xda\x28\xb9\x6b\xa0\xe4\xae\xff\x33\x29\xb9\x1b\xa4\xe4\xde\x12\x0d\xf7\x46\xd1\x70\x1f\xea\x34\xdc\xad\x1f\x7c\x56\x34\xdc\x07\x41\xc3\x7d\x10\x34\xdc\x07\x41\xc3\x2d\x88\x86\xbb\x33\x68\xb8\xcf\xc1\x9d\x45\xc3\xe1\x1c\xcf\x83\x4f\xe8\x8d\xe1\x5a\xdd\x66\x07\xb1\x78\x21\x86\x55\xb9\x8b\x9a\x77\xbf\x77\xe7\x6b\x4c\xfe\x31\xf8\x09\x95\x99\x2f\x34\x3d\x07\x1b\xfc\x31\xba\x90\xa2\x45\x2d\xe1\xf8\xf4\x37\xef\x23\x11\x75\x1b\x82\xc2\x39\x47\x17\x9f\xf6\xf7\xcf\xa3\xe8\x23\xa9\x1c\x68\x79\xc0\x27\x14\x59\x9e\x8b\x64\xa0\x21\x66\x93\x8f\x92\x30\xe1\xc2\x8e\x2f\xe1\x97\x48\x51\x96\xf9\xc9\xe9\xb8\x3c\x41\x02\x68\x9c\x07\xf7\x91\x2a\xbb\x0d\xbe\x5c\x05\x66\xd5\x68\x73\x82\xb6\x4e\xd1\x1b\x28\x7a\x62\x14\x3b\xbd\x1a\x1b\x5f\x98\x7b\x35\x56\xdf\xdc\x00\xf2\x3e\xe2\x74\x9d\x4a\x86\xfb\x61\x73\x32\x01\x40\x01\x60\x59\xd6\x5a\x38\x05\x30\xa7\xd2\x6f\xfd\x13\xef\x4e\xde\x51\x6a\x1c\xc1\x3d\x11\x60\x77\xf2\x34\x99\x19\x43\x22\xf8\x36\x58\xad\x91\xfb\x86\x48\xbc\xf6\x6a\x6d\x39\xc1\x1c\x76\xf2\x27\x71\x85\xbd\x85\x66\x46\xe8\x42\x42\x24\x94\x40\x34\xe9\xb5\xe0\xe4\xa2\x31\x19\x3e\xd8\x2b\x04\xca\x37\xd1\x3d\x00\xa6\xca\xe0\xeb\x02\xd0\x10\xf0\x26\x83\x9f\x7c\x82\x05\x01\xbc\xe2\x36\xfd\xa8\x75\xbb\x24\xfd\x6f\x24\x21\x91\x6c\x6d\x10\x1e\x3f\x23\x1f\x0f\xa2\x1f\x96\x11\x8c\x62\x7f\xff\x23\x4a\x8e\xa1\x3b\x7b\x68\xb2\x5b\x5c\x18\x49\xb0\xee\x6c\xbd\x8c\x3f\xac\x89\x21\x1e\xd2\xa7\xa8\x91\x98\xc4\x29\x71\xd2\x53\x7c\x6f\x10\x46\xfa\xf1\x7c\xfb\x53\xc6\x0d\xfe\x39\x10\xa2\xa2\xe9\x89\x67\xb3\xa7\xa4\xdc\xd0\x99\xb3\x55\xc1\x10\x09\xcc\x3b\x37\xf9\xba\xe8\xc7\xc5\x82\x1e\x2e\x3b\xe2\xd2\x23\x25\xf7\xaa\x58\xcf\x00\x61\x74\xe5\xdb\xf6\x34\x4f\xe7\xd4\xf9\x74\xaa\x06\x25\x6c\x57\xa7\x56\xba\x6f\x68\x08\xfc\x72\xfa\xf1\x6d\x24\x4d\xe5\xbb\x80\x8b\x7e\x7b\xfb\xe5\xfc\xfd\xa7\x5f\xa2\xee\x68\x30\x1a\x0c\x31\x65\x3a\x2d\x60\x38\xd3\x69\xd3\x90\xa6\x3b\xe9\xf6\x78\x13\xbd\x6e\x07\x7f\x8a\xca\xa8\x80\x8a\x57\x7e\x95\x9f\x93\x25\xb4\xcb\x04\x47\x35\xcb\x1f\x78\xa4\x5d\xde\x97\x4f\x1f\x3e\x4c\x3f\xbf\xfd\xf2\xfe\xd3\x19\xf0\x03\x3a\xfd\x3f\xde\x9f\x5d\xfc\x1c\xfd\xf9\x2f\x43\x23\xed\xe7\xb7\xef\xdf\xfd\x7c\x11\x7d\x77\x84\x89\xa7\xbf\xbc\xff\x78\x7a\x01\x9d\x4f\xcf\x2f\xde\x7e\x3e\x8f\x46\x47\x56\xe2\xd9\xaf\x5f\xe8\x47\x74\x34\xc4\xd2\x7f\xfb\xf8\xe3\xf4\xc3\xe9\x8f\x6f\x3f\x9c\x47\x93\xee\xdf\x00\xed\x7d\x84\xff\x7e\x84\xff\x2e\xe0\xbf\xff\xbb\x7b\x45\x45\xde\x1d\x4d\x7f\x7c\xff\x4e\x97\xfb\x26\xca\xbd\x13\xe5\x3e\xc3\x7f\x6f\xe1\xbf\xbf\xc3\x7f\x97\xba\xce\xf9\xc7\x53\x98\x83\xaa\x75\x0b\xb9\x6b\xf8\x2f\x83\xff\x56\xf0\xdf\x0d\xfc\x17\xc3\x7f\xbf\x77\xd1\x2c\x13\x6b\x71\xeb\x4e\xd2\x9a\xfe\x09\x15\x0a\x6b\x14\x52\xa0\xf4\x65\x01\x6d\x97\xc9\xe2\xa7\x64\x21\xe4\x20\xe2\x89\x24\xd1\xbe\x15\x6e\xd2\x3c\xae\x78\x23\x50\x31\xe1\xb8\x1a\x1f\xc5\x63\x54\x3f\x58\x24\x55\x79\x7a\x03\xad\x9f\xb1\x59\x72\x1b\xa7\xe4\x5d\x09\x72\x6e\xe3\xcd\x2f\x34\x06\x2e\xfd\x42\xb5\xd0\x58\x3a\x2b\x80\x95\x22\xb1\xb3\x91\xf0\xee\x48\x88\x61\xb8\xf2\x3c\x1c\x30\xee\x2c\xe5\xba\x04\x72\xee\x98\x13\xc8\xab\xfc\xde\x1b\x01\x23\x07\x67\xd5\xc8\x7c\x6d\xe6\xf5\x33\xdf\x3f\x41\x6d\x85\xb7\x9b\x55\x9e\x01\x74\xc3\xa1\x41\x6b\xeb\x2e\x42\x12\x11\xd8\x53\x98\x03\x2a\xa9\xe2\xab\x0c\xa7\xf2\x81\x08\x82\xe3\xb3\xc0\xcb\x83\xe4\xd1\xd1\x88\x7d\x17\xa0\xb6\x83\xde\x50\x18\x2c\x12\xe1\x75\xb9\xcf\xb9\x30\xf4\x00\xde\x7f\xd9\x51\x53\x23\x7b\x10\x3d\xaf\x41\xe7\x73\x32\xfb\x86\x4e\x88\xf6\xba\x28\x7d\x1b\x0d\x8f\xfe\x2c\x9a\xb7\x80\x01\x46\xe0\xd8\x6c\x7d\xbf\xcd\x23\x63\xd2\x40\x66\x55\x34\x67\x18\xbd\xd4\xc6\x03\x52\x6b\xa5\xc9\xa6\x19\x52\x41\xb3\x7e\x3f\x58\x1f\x02\x8f\x86\xd7\xdc\x71\xb4\x46\x35\x03\xbd\x0e\x87\x6b\x58\x89\xde\x6a\x32\xbb\x12\x9a\x07\xf8\x44\xc7\x17\x65\x1b\xf1\x53\xe6\xd5\xd7\xd2\xf7\xa5\xcb\x29\xd6\x87\x1d\x43\x7f\x31\x5b\xd1\xe9\xfe\x3e\xb2\xf3\xc7\xd1\x77\xfc\xc7\xeb\xe8\xe8\xcf\xb0\x68\x45\x84\x1f\x7f\xfa\xee\x78\x78\xa2\x7a\x46\xd1\xc1\x61\x25\xf7\x8c\xe7\xfb\x30\x96\x31\x87\x18\xca\xf7\xa1\xe3\x9f\x92\x0d\x9b\x7b\x47\x28\x52\x8c\x16\x13\x9a\x3e\x00\x63\x8e\x05\x46\x57\x87\xdf\xf9\x7d\x22\xfb\x04\xa0\x16\x3b\x05\xf6\xa8\xdf\x4b\xb4\xbb\x1b\xf4\x11\xb1\x2a\xe8\x76\x1d\x14\x02\x73\x6c\xee\xfc\xe7\x4f\x5f\x2e\xa6\x1f\x3f\xfd\x72\xf1\xf3\x14\x51\xd2\x39\x60\xda\xee\x7f\x8f\xf1\xd8\xfd\xc4\xae\xf1\xe8\xc6\x05\xfc\x3d\x5d\x15\xf4\x7b\x0b\x7f\xff\xfb\x3a\xa3\xbf\x29\xa6\xaf\x17\xf0\x17\x08\x2b\xf8\xfb\x09\xd0\x7c\xd0\xfd\x25\xbf\x83\xbf\x70\x54\xe8\x90\xe2\xd3\xd7\x0b\xc6\x2a\x48\x62\x79\x58\x7e\xbd\x78\x03\x80\x94\x45\x05\x2c\xe8\x19\xb6\x30\x9b\xb1\xb2\x04\x6a\x1b\x32\xc6\xb5\xa4\x0f\xf9\x8c\xc8\x96\x8c\xd4\xa9\xe1\x68\x5f\xb2\x18\xc5\xdf\x70\x1a\x29\xe9\x23\xd0\x39\x64\xff\x98\xf2\x6f\xac\x8c\x9f\x4b\xfe\xf9\x33\x5c\x14\x04\x6e\x2b\x51\x1c\x68\xa2\x8a\x51\xca\x82\xa7\x9c\x33\x38\x12\x73\x4a\x99\xd7\x53\x88\xc2\x3e\x8e\x10\xb9\xbe\x39\x3d\x3b\xfd\x20\x50\x0a\x9c\xc5\x5c\x65\xd1\xda\x7e\xb8\xd4\xd8\xa6\xb9\xe4\xc0\x83\xf7\xba\xfb\xff\x36\xfa\x61\x18\x62\x4d\xee\x6b\xe9\xbb\x1f\x86\xc3\x83\x65\xef\x87\xe1\xc1\x0a\xa8\xcb\xc1\x70\x38\x3a\x98\x2b\x4f\x2a\x00\x94\xeb\xc7\x47\xde\xf5\xe9\xfb\x0f\x97\xb0\x50\xbf\x33\xa0\xc8\xe3\x39\xc0\xb7\x6e\xab\xa5\x33\x34\xc4\xbb\x2d\x39\xfc\x4f\xf1\x80\x05\x0b\x02\x06\xb9\x5b\x7a\xa3\xda\x76\x50\x14\x7e\x12\xfb\xaa\x09\x63\x49\xd9\x19\xe4\x58\xbb\x4c\xfd\xaa\x57\x13\xa7\x72\xa7\x7e\x55\x31\x6e\x31\xb2\x44\x8f\x1e\x94\x05\x37\x7f\x49\x40\xf1\xfe\xf8\xbb\xc0\xe1\xf9\x67\xcc\xe5\x41\x76\xc6\x8f\x4a\x73\x89\x18\xc0\xf1\xe0\xfb\x80\x0f\xed\x2c\xb9\xe3\x6f\x50\x47\xdf\x0f\x75\x12\xd1\xf5\xe8\xb4\x42\x24\x49\x7e\x02\x45\xfe\xe5\x18\xa8\x68\x91\xbc\xcc\xef\xff\x0e\x9b\x41\x8b\x03\xe9\xb2\x05\x40\x9c\xba\x10\xa2\x41\xfc\x42\x07\x79\x9c\x09\xff\x94\x29\xab\x76\xac\xd3\xbc\x77\xc6\x47\x81\x7d\xe5\x8c\x7f\x08\xc4\xc5\xc6\x67\x67\x3e\x9e\x8d\x82\xc6\xfb\xcc\x78\x18\x34\x9e\x33\xc6\xdd\xfb\x65\x82\xba\x71\xe4\x18\x04\xb0\xb8\x58\xc1\x58\xef\x38\x7f\x34\x19\x8f\xfe\x1c\x48\x3b\xdc\xf1\xf7\x34\xec\x2f\x79\x9a\xb2\x02\x27\x61\xda\x4e\x8e\xf9\x7e\xf3\x0f\x54\x11\x46\x73\x35\x48\x06\xa4\x80\xa3\xbd\x8d\x61\x1a\xea\xe9\x02\x2b\xdf\x14\xf1\x8c\x7b\x03\x81\x8f\xfb\x24\x2d\xf3\xec\x3d\x70\xb1\x05\x70\x23\xb8\x0e\xfa\x15\x83\x0a\x4b\xd6\x55\x7e\x88\x8d\x1b\x7d\x1f\xb8\x99\x3c\x5a\x63\x83\x7f\xaf\x7f\x03\x91\xfc\x13\x8a\x7d\xba\x71\x0a\xf8\x6c\x99\xcc\xd9\xa7\x3b\x56\xa4\xf1\xf6\x53\xf6\x31\x07\xfa\xf5\xd3\x9a\x76\x23\x65\x0b\x80\xc6\x71\x37\xcf\x6e\x31\x15\x98\x0c\xc0\x87\x92\x3d\xc6\x36\x63\x24\x4e\x01\x79\xe0\xc6\xd3\x92\x90\x25\xd7\xe7\x78\x8e\x8a\x6c\xea\x37\xd7\xac\x01\x0a\x17\x95\xf6\x4f\x2b\x59\xb8\x4a\xaa\x94\xfd\x4c\xda\x64\xe3\xa3\xbf\x04\x1b\x5a\x79\xf1\x3d\xfa\x4b\xb0\xa5\x6f\xb1\xaf\x7f\xa1\xfa\x5f\xc9\x37\x0b\x82\x09\x7c\x5c\xca\x0f\xda\x35\x7c\x9d\xe7\x5b\x7b\x9d\xd2\x83\x9b\x4c\xe5\x0d\x0c\xbe\x0b\x16\x45\x32\xb7\x12\xd4\x6e\x3b\x2a\xea\xae\xbf\x17\xbd\xbd\x83\xea\xb2\xeb\xaf\xf2\x43\xb6\x29\x5a\x00\x6e\xd9\x1b\xc1\x54\xc4\x7f\x7e\x37\x48\x70\x4f\xf9\x4e\x7f\xcc\x01\x2c\xf8\x5a\xc4\x19\x40\x36\x6c\xd6\xdf\xf3\xfc\xb6\x94\xe7\x81\x96\xeb\x9c\xa5\x0c\x09\x75\x4c\x2c\xcc\x04\xb1\x2e\x7f\x1e\xda\xc9\xb8\x11\xe7\x04\xde\x62\x08\xff\xf6\x97\xe1\x5f\x7e\x3a\x05\xea\xb4\x51\x0c\x37\x5c\x16\x3a\xfd\xf7\x1f\x47\x6f\xfe\xdc\xa5\x7e\xdf\x67\x76\xcf\x34\x42\xf1\x9e\x3f\x9e\x68\x34\x35\x30\x64\x72\x06\xf6\x1a\x98\x8f\x7b\x66\xba\xf1\x06\x7b\x05\xed\xad\x17\x49\x46\x8e\xa9\xd0\x8f\xc6\xf8\x61\x33\x7e\x58\x01\x15\x90\x96\x9f\x59\x41\xab\x3d\xfe\xf7\x61\x7d\xe9\x7f\x18\x9a\x27\x52\xa0\xdb\x71\x2b\x72\xbe\xb3\xd0\xf2\xd8\x85\xab\x69\xf7\xcc\x9d\x94\x30\x84\xce\xbd\x50\x95\x15\xa8\x21\xb2\x7a\xc1\x34\xb4\xf8\x60\x85\xd0\x47\xdc\x8e\x1f\x9a\x80\x51\x9b\xc1\x77\xc3\xe6\x18\x5c\x64\x88\x7b\x56\x6d\x24\xce\xbf\x30\xe6\xa3\xff\xb3\x83\x36\x4f\x2b\x9f\xc0\xc8\x31\xe8\x91\x35\x68\x12\xff\xc2\xed\xfd\xfe\xef\x70\x79\x9f\x7e\x20\xbe\x0e\x38\xc5\x8b\xf7\x6f\xe0\x03\xd9\xb4\xcf\x1f\x7e\x7d\xf7\xfe\x97\x73\xb2\x99\x40\x57\x10\x6c\xae\xed\x6d\xde\x9c\x9f\xa3\x58\xc7\xd2\xde\x31\x38\xdd\x86\xea\x27\x09\x88\x14\x63\x64\x28\x53\x4d\xba\x48\x30\x75\xaf\x84\x12\x55\x62\x2a\x51\x65\xc2\x48\x7b\x92\x5f\xa1\xc6\xa2\xb0\xf4\x2e\x82\x07\x7e\xc5\xa1\x86\xc4\xce\x66\xa4\xb1\xb7\xa2\xae\x3d\xee\x1e\x13\x8d\xe8\xf0\xe3\xf9\xfb\xb7\x87\x03\x20\xc8\x2a\x2f\x8b\xef\x92\x05\xca\xfa\xc8\x7d\xd1\xe9\x02\x4d\x24\xf6\xf7\xf7\xee\x61\x1d\xf3\xfb\x01\x0a\x23\xe3\xfd\x7d\x97\xcf\xd5\x77\xd3\xbb\xdb\x94\x93\x14\x1f\xe3\x2c\x5e\xb0\x02\xca\xa1\xd5\x2d\x5a\xaf\x74\xb5\x7e\x33\x17\x36\x9c\xa3\x71\x8d\x69\xe8\x10\x9a\x66\xf2\x25\xab\xd0\xef\x6d\xbe\xae\x3c\x93\x3b\xb7\x67\x88\x94\xf5\x68\x38\xf4\x91\xdb\x90\x2f\x1d\x89\xf1\x3a\x9b\x90\xe9\x6d\x84\xde\xa6\x12\xa0\xb0\x6e\xe3\xd5\x07\xb6\x88\x67\x5b\xe1\x51\x65\x8a\x6a\x2b\x4d\xc7\x81\xe4\x97\x48\x8d\x15\xc8\x50\xa1\x21\xff\xe3\xf6\xfd\x1c\xbd\xd2\x04\x7b\xec\x29\x8b\xfe\x37\x52\xf6\x41\x92\x12\x21\x10\xa1\xb7\xfb\xb8\x93\xe5\x59\x9f\x01\x88\x56\x24\x2c\x49\xee\x80\x8d\x93\xce\x64\x6d\xb3\x91\xe8\x65\xeb\x2b\x9c\xab\xc6\xb0\x35\xc9\xdd\x54\x9a\xcf\xa0\x4f\xe0\xa9\xb4\x9a\x29\x80\x78\x80\x93\x97\xe4\xf3\x69\x94\x18\x5f\x8f\x8f\x4e\xa9\x86\x30\x27\x2a\xd8\x5d\x02\xf7\xef\x6f\x28\x78\x06\xb2\xff\xeb\x34\xea\x0b\x53\x67\x45\x45\x60\x73\xea\xe3\xf1\x71\x4f\x9a\x42\xc3\xae\xfe\x07\x81\x0a\x16\xd0\x5f\x8f\x8f\xda\xdf\x8a\x61\xff\xa5\x1d\x4c\xfe\x0e\x37\x13\x9b\x4f\x37\xd3\x48\x36\x25\x52\xb6\x94\x82\x4e\x80\x33\x56\xfc\x7c\xf1\xf1\x43\xd4\x05\x1a\xa7\xcb\x9f\x5f\xb4\xf1\xc0\xfe\x7e\x22\x7f\x78\x56\x46\x24\xd2\xc9\x82\xc0\xb7\x6b\x72\xcd\x72\xac\x2a\x7f\x79\x76\x56\x24\x73\x9e\xaa\xcd\x9f\x82\x66\x69\x02\xdb\xfa\x73\x4b\x3b\x75\xb9\x10\xb7\x67\x70\x4d\xa3\x36\xfa\x9a\x90\x89\x8f\xc3\x37\x8c\x4f\xa6\xaa\x6f\x42\xb8\xf8\x64\x74\xcf\x7f\x0c\x4d\xbb\x94\x69\x6d\x88\x58\x6e\x29\x7e\x0d\xc9\xe0\x42\x13\x6b\x64\xb1\xae\x48\xc0\x68\x4f\xfa\x8e\x35\x3c\x85\x92\x7f\x06\xcb\x79\x9b\x91\x89\x2a\x23\x7c\xa7\x1b\x65\xcf\x18\x5b\x79\x46\x5e\x9d\xd5\x10\x15\xaf\x91\x59\x88\x0b\x38\x72\x06\x88\x00\x52\xa0\x97\xb6\x1f\xb7\xf8\x04\xa7\xdd\x9e\xce\xb9\xe1\x9d\xf0\x0c\x2a\x4b\x17\x6c\x81\x27\xad\x60\x73\xe1\xf2\x44\xe5\x30\xfc\xfe\x40\xc7\x90\x15\x86\xfb\x54\xc3\x66\x38\x42\x6f\xcf\xb6\x73\x57\xe1\x7a\x96\x6b\xf2\xf1\x07\x13\x24\xb5\x6e\xe2\x19\x9b\x4a\x0b\x1e\x41\x74\x4c\x4d\x5f\x28\xa8\x28\x2b\xae\x10\x74\x88\x3d\x8b\x85\xf5\x94\xa5\xcb\x48\xd5\x70\x5f\x39\xfa\xcf\x9a\x3a\xb4\xc8\x57\xa3\x16\x6d\xe9\xc4\x0d\xe9\x00\x4f\xe2\x1d\x0c\xea\x04\x28\x3e\x18\x7b\x2a\xf5\x2c\x79\xdb\xe3\x32\xa0\x59\x13\x57\xa5\xbd\x02\x0a\x2a\xe9\x93\xe1\x9f\x73\x15\x95\xaa\xad\x9a\x27\xe4\x05\x2a\x3a\xae\xfc\x55\xdd\xd5\xc9\x02\xf1\xec\x92\x2f\x6b\x39\x59\x5c\x45\x2b\xf8\x23\x30\x9b\x5c\x11\x7e\x85\x2d\x7d\xa5\xb2\xc0\x27\x6a\x97\xa9\x4f\x7a\x1e\x59\xf9\x38\x7d\x6b\x2c\x73\xd1\x27\x09\xa5\xc4\xef\xe6\xe0\x78\x5b\xeb\x68\xae\x47\x18\xcc\xa2\xc9\x5c\xb4\x1b\xac\xaf\x42\x6a\xcd\x05\x1b\x27\xae\x44\x68\x80\x4f\x67\x26\x04\xd8\xcd\xfc\x68\x32\xbb\xda\xed\x0c\x58\x39\x2b\xe2\x45\x13\x5e\xb8\xf6\x7d\xdd\xce\xcb\xf6\xd4\x53\xe3\xf6\xb9\xba\x49\xdb\x78\xd5\x73\xee\x28\x14\xee\x72\x4c\xdd\xce\x80\x5b\xc7\xa1\x9b\x78\xa4\x82\xe6\xdc\x53\xdc\xe7\x82\x1a\x61\x44\x2d\xad\xf8\x87\x70\x22\x37\xb6\x1d\x67\xef\x25\x03\xdd\x82\x36\x9b\x44\x7b\x49\xd4\xe8\xee\xd8\x95\x3b\x79\x46\x37\x9e\xae\xd2\xa1\x6c\xb4\xa8\x1c\xd4\xfb\x06\x24\xb3\x43\x87\xf6\xab\x78\x41\x37\xc3\x79\x95\xaf\x56\x7c\x48\xc0\x97\xae\x3e\xeb\x1c\x6b\x4c\x83\x66\x15\x6c\x69\xa7\xc9\xa4\x44\x9a\xe7\x0b\x1b\xc7\xfa\x5e\x31\xe1\x0a\x50\x1f\xd7\x42\x8b\x3e\x33\x14\x7d\x66\xf2\x01\x38\x8f\x8a\x49\x46\xbe\xbb\x4b\xfe\x6b\x44\x75\x4b\xae\xce\x9e\x23\xd6\x73\x0d\x88\xdb\x49\xec\x94\x47\xba\xfa\xd4\x1d\xbe\xc1\x10\x2e\xc9\xef\x3a\xac\x9c\xed\x19\x52\xdb\xbb\x0d\x43\xe6\x3e\x3e\xac\x66\xc9\xad\x8e\x0f\x13\x2f\xd5\x3c\x81\xfb\xbc\x86\x0e\xd0\x5d\xbe\xa4\x68\x64\xe6\xce\xd0\x79\xb3\xc7\x97\x94\x7f\xa7\x7b\xb9\xae\x03\xe5\xd2\x8e\xb3\x9c\x00\xaa\x0b\x5e\x28\xad\xab\xeb\x3d\xe4\x1e\x15\xdb\x2b\x50\x81\x6d\x6b\x01\x68\x81\x83\x22\xb9\x2f\x41\xa9\xc4\x2d\x10\x9c\x05\x5a\xf2\xe2\x0b\x50\xaf\x7b\xc5\x7d\x3d\x5d\x33\x9a\x4e\x40\xce\x9e\xf2\xa2\xf3\x6a\xfb\x6a\xd0\xb5\x27\xe7\x7a\x12\x32\x6c\x07\x24\xa5\x85\x66\x03\xa8\xa8\x93\xcc\x4f\xf0\xcf\xb8\x0a\xe5\x8b\x93\xb8\x32\xd0\x49\xa1\x7c\x66\x32\xdc\xc8\xc1\xfd\x32\x75\x9b\x91\xb1\x93\xfa\x0d\x64\x7b\xad\xc0\xb2\x63\x57\x11\x97\x6f\x39\x8e\xce\xdd\x1d\xa9\x36\xe8\xb5\xad\x59\xd7\x52\xda\x78\xa2\x09\x7d\x7b\x39\x9b\xe1\xcb\xf8\xaf\xb6\x62\xa9\x17\xfc\xf3\xcd\xfc\x24\x2a\xfe\xab\xed\x58\xce\x52\x9e\x59\x5e\x7b\x17\x85\x8b\xc9\x7a\x9b\xe2\x22\xfe\x2d\x61\xf7\xa2\xd0\xb4\xc5\xb3\x6d\x58\x77\x27\xaf\x5e\xdd\x98\x49\x78\x91\xcb\x50\xe5\x7e\x06\xe8\xda\x49\x75\xc5\xff\xd6\xaf\xc5\x98\xfb\xc1\x9c\xc4\x57\x63\x71\xf6\x80\x59\x53\xae\x51\xc8\x71\xeb\xde\x68\xec\x22\x35\xac\x0e\xa1\xfe\x49\x3d\x61\xec\xe1\xa8\x8c\x01\x05\x2f\x1e\x09\x1e\xf2\x0a\xb5\x1c\x63\xe9\x7d\xb8\x59\x58\x67\xf2\x2a\x47\x76\x9d\xd1\x53\x75\x46\x54\x47\x9c\x44\xc8\xa8\x1b\x11\x69\xfe\xa8\xcd\x2f\xa3\xc1\x4f\xd9\x55\xb5\x23\xb0\xb6\xaa\x06\x6b\x74\x52\x4f\x18\x6b\x4f\xb5\x6f\x37\x55\x01\x0c\x67\xc3\x31\x99\x95\xd9\x82\xa0\x9a\x5a\x57\x4a\x3e\xda\xf5\x0f\xc5\x6d\x40\x02\xb2\xa9\x30\x58\x0a\x85\x9b\x7a\xca\xcb\xd6\xb7\x5f\xf2\x7b\xe8\x59\xa0\xd9\xc9\xb0\x5f\x05\xa3\x5e\xa5\x3c\x95\xd1\x0a\xc4\xf7\xe8\xe9\x62\x2a\x42\x5e\xc4\xb5\x54\xeb\x4b\x47\xe7\x40\xf7\x66\xa8\xa7\xa5\x1e\x8b\xfb\x2c\x64\xfd\x28\x39\xa8\x82\xb8\x87\xff\x88\x1b\x67\x02\x44\xce\x95\x3d\xf1\xad\x63\x65\x1b\x91\x5f\x14\xff\x8e\xbc\x57\x15\x01\xc7\x32\x3c\x86\xbb\xa8\x3a\x16\x76\x57\x86\x77\x17\xdf\xb8\xdb\xcc\x89\xc5\xc2\xc1\x4b\x28\x47\xd2\xe2\x54\x8c\xb9\x5d\x89\xb5\x8e\xba\xc5\x1a\x0d\xb8\x11\xe3\x12\x37\x07\x48\x37\x78\x25\x5c\x87\x0a\xdf\x33\xd2\xc9\x1c\xf3\x7d\xed\x62\xb5\x76\x77\x9d\xe5\xb7\xe4\x51\xa6\x6c\xf1\xa5\xc5\xf7\x86\xca\x09\xdf\x39\x95\xa0\x43\x29\x4d\xba\xa3\x81\x0a\x57\x8e\xa6\x79\x95\xb6\x6b\xbf\x6d\x51\x2d\x70\x93\xc0\x62\x39\xcd\x93\x37\xe0\x60\xd3\xf3\xaa\x7e\x8c\x0f\xb6\x87\x5e\x8c\x3e\xf1\xe8\xf7\x01\x30\xc2\x8e\xd1\x5c\xd6\x47\xa3\x2c\xe3\x22\x31\x23\xdb\xc9\x0e\xe6\x87\x7a\xbc\x71\x63\xbc\x89\x63\xbc\xda\x8b\xf0\xb6\x17\x1f\x00\xbf\xdc\x18\x08\x00\xf9\xcb\x96\x1c\x0a\x36\xd7\x1c\x12\x9f\x5c\x74\x55\xa9\xae\x91\x2a\x66\xc1\x1a\xb3\x88\x5d\xab\x9e\x38\x56\x5d\xf9\x34\x71\xdf\x55\x35\x4f\x60\x52\x4e\xe7\xb1\x7e\x3c\xd8\xf8\x87\xe8\xde\x03\xf9\x5a\xe1\xaa\x8e\x14\xb2\x72\xe3\x9b\x74\xf1\xa3\xac\x57\x1c\x78\x79\x9f\xbc\x73\x29\xd5\x8a\x6a\xf0\xe1\xd3\xbb\xe9\xf9\x9b\xd3\x0f\x6f\x83\x52\xed\x7e\xaa\xc8\x63\xb4\x4f\x30\xba\x39\xa0\xd6\xfa\xd4\x85\x6b\x7d\xea\x70\x20\x79\xa4\xd6\x15\x72\xed\x73\xa0\x7d\x3c\xc9\x15\x8a\x69\x89\x5c\x08\x06\x0d\x7e\xe3\x68\xe8\x07\x2f\x5c\xbf\xd8\x97\xfa\xa6\x30\x2d\x80\x24\xff\x10\x00\xc9\x58\xad\x82\x1b\x39\x18\xdf\xb8\x7a\x69\x54\xf6\xb3\x03\xaf\xec\xe7\xf8\x84\xee\x5c\xbd\x54\xad\xde\x52\x69\x2e\xd0\xea\x21\xb8\x26\xe8\x54\x96\xba\x3a\xa0\x16\xfb\x85\x6b\x05\xad\x33\xf2\xc2\x45\x7c\x6a\x4d\xf8\x0a\x3b\x97\x53\x5a\xa5\xbe\x64\xc1\xb8\xfa\xb1\xf4\xa6\xfe\xd4\x3a\x85\x49\x04\x6b\x54\x29\x07\x7d\x80\x3a\x70\xc9\xb8\x1e\x1f\xe4\xd1\xd4\x61\x1d\x8c\x35\x50\x87\xba\x65\x31\xfe\xe8\x89\x73\x1d\x2f\xb9\x02\x2f\x3b\x5d\x5c\x5b\x29\x42\x39\x9b\x8c\x36\xf1\xd4\xc9\x0a\xe3\xc8\xd3\x33\x86\xe3\x75\x48\x87\x8c\xcf\x39\x26\x28\xe3\x48\x54\x9f\x1c\x39\xe7\xb8\xe1\xdc\xfc\x4d\x9e\xae\x6f\xb3\x56\xb7\xde\xf2\x22\x3f\xa9\x5f\xfd\x8d\x04\x71\x77\x8d\x0d\x06\x43\x05\x94\x91\x79\xc3\x46\xf7\x48\x6e\xfc\xb1\xbe\x5b\xda\x82\xd5\xa5\xdb\xd8\x4d\x96\x0b\x5a\xc0\xd5\xd0\x09\xee\xe4\x78\x78\xcc\x1e\x1f\xd9\x71\x8d\x94\xb9\xb2\xca\xd4\x33\x81\x9f\xae\xc9\x6d\x6a\x52\xc1\x96\x58\x3b\x92\x8b\xe4\x02\x32\xe9\x01\xe6\x69\xbf\x3c\x81\x55\x56\x7a\xd2\x61\x9b\xea\x34\x4d\x16\x59\xd4\x45\x57\x3b\x5d\x67\x21\xed\xa0\xa7\x60\x69\x5c\x25\x77\x0c\xfd\x7d\x98\x6e\x74\xac\x5a\x52\xc0\x29\xde\x21\xe4\x68\xf8\xb3\x83\x67\xe7\x3e\xe1\x02\x48\xb8\xfd\x27\x2f\x52\x91\x21\x06\xc3\xe7\xd9\xbf\x25\x42\xf3\x7a\xea\x99\xad\xd9\x6d\xa3\xeb\xa9\xa8\xe2\xfa\xff\xa4\x6b\xef\x2a\x6b\xb8\xa9\x72\x94\x15\xb9\x32\xee\x19\x2b\x93\xdf\xe5\xaa\x96\x4a\x02\xa7\x56\xab\xb1\x22\x76\xf5\xf6\x72\xf6\x90\x48\x6d\x81\x24\x77\xa2\x2b\x6b\xfa\x1f\x1b\xb9\x72\x1c\xdc\x2e\xdf\x92\x3b\x73\x9f\xf9\x42\x06\xab\x2f\xf7\x50\xf7\xf3\x31\xbf\x63\xd2\x87\xa3\x45\x98\xc5\x3a\x5f\xc4\x81\x50\x75\x3e\xad\xab\x66\x15\xa6\xb9\xc9\x2a\x2e\x60\x21\xd1\xed\x05\x86\x9d\x12\xc3\x24\x53\x65\x82\x1f\x36\xbf\x50\x05\xaa\x5c\x64\x87\xd5\x20\x29\xd1\xc5\x10\xae\x7f\x8c\x57\xc3\x8f\x5b\x14\xbc\x69\xa8\x42\x6b\x32\x47\x99\xc2\x2e\x13\xab\x21\x4e\x3d\x15\xff\x2f\x9e\xcf\x4f\xb3\xf9\x45\x11\xcf\xbe\xd1\xca\x79\xfc\x25\x32\xe8\x72\x0d\x91\xb5\x84\xfb\xfa\xec\xfc\x96\xea\x2d\xdb\x24\xda\x43\xbd\x76\xb3\x41\x73\x89\x2d\x48\x92\x89\x8f\x8f\x9e\x23\xd5\x0a\x8a\x28\xf2\xbc\x67\x67\xc4\xcb\x75\x5d\xdd\xf8\x0d\xbf\x62\x16\x38\x37\x1c\x8c\xd5\xb0\x80\x78\x4b\xd2\x8f\x46\xfc\xfd\xc9\x55\x54\x3e\x58\x19\x4f\x47\x54\x58\x52\xf5\xc6\x39\xfb\x8c\x0f\xfb\x5f\x50\xd6\xe9\xd5\xcf\xae\x10\xff\x4b\x4c\xd1\xe8\xff\x80\xd9\xa8\xc4\xd1\x6b\xbd\xc8\xf3\x33\xb1\x4b\xb6\x4d\x24\x18\x71\xbd\xe0\xfa\x90\xb9\x8b\x60\xb4\x29\x57\xc7\xed\x89\xb9\x1a\xb8\x47\xcc\x55\xa4\x38\xe6\x1a\x5b\xe8\xca\x39\xd7\x5a\x91\xe7\xe7\x6a\x97\x7c\x72\xae\xb1\x8a\xda\xa2\x86\x2c\xe6\x1a\xa3\xba\xbd\x05\x57\x73\x86\xba\x6b\xdb\x06\x3c\x99\xeb\xa4\x83\x33\x36\x9a\x55\x59\xf5\x50\x00\x35\x21\x75\x7f\x14\x32\x94\xad\x33\x29\x5b\xaf\xc9\xaa\x45\x84\x59\x29\x8e\x96\xc3\x42\x14\x61\xa7\xc0\xa1\xe2\x98\xab\x2e\x24\x07\x9c\xb4\x8c\xcb\x37\xca\x33\x1b\xc6\xab\x4d\x3c\x34\x32\x2c\xca\x8a\x92\xd1\x46\xd0\xf4\xe3\x66\xe5\x89\xe8\x09\x3c\x9f\x8e\xaa\x7a\x47\xf4\x74\xc5\x3f\x8a\x90\xac\x6a\xff\x12\x22\x72\x0e\xe0\x29\xfc\xe1\x4a\x14\xa6\xcd\x9e\x45\x96\xc8\xd7\x12\xe7\xab\x03\xbd\x3b\xf9\x8d\x90\x79\xe8\x2c\x07\xe5\x2e\x18\x2c\x98\xfc\x8f\xed\x42\xe1\x6c\x57\xdc\x6b\x40\x11\x7b\x16\xdf\x25\x13\x7c\x17\x09\x65\x53\x09\x0e\xff\x0d\x75\xb2\x44\xd1\xb7\x4f\x90\x24\xb1\xe1\x7f\x90\xe9\xdf\x2a\x9d\xbc\x15\x32\xe3\x23\x88\x9b\x07\x10\xd2\x1c\x47\x4d\xb5\xd1\x72\x60\xe3\xe7\x4e\x69\xec\x5a\x85\x26\xb1\x50\x8b\x63\xeb\x54\x1d\x91\xf2\xc0\xa7\xdd\x3c\x2a\x9d\xe1\x27\x48\xb8\x16\xdf\x8f\x52\x93\x55\xe6\xdf\x24\x29\xea\x24\x77\x63\xd4\x10\xf5\xf2\x55\x3c\x4b\xaa\x2d\xf0\x82\xba\x44\x1b\x1a\x7b\x6e\x55\x9e\x20\xbc\x00\x9c\x77\x26\xaf\x20\x26\xdf\x08\x49\xc1\x8d\x47\xa7\xed\x51\x2f\xa5\x61\x6e\x60\xd9\xea\x1b\xee\x7a\xb4\xda\x80\x76\x88\x24\xbd\x17\x19\xf2\x84\xa6\xe4\x95\x8a\x9f\xc7\x30\x46\x7a\x0c\x44\xef\x21\x23\xc9\x17\xb6\x94\x26\x0e\x06\x0b\x0e\xbe\x0f\x84\x29\xf7\x8c\x25\xa9\x17\x1f\x1e\xf9\x81\x8e\x2b\x66\xd5\x29\xbb\xca\x4f\xba\x69\x3c\x1c\xa4\xe8\x12\xe0\x38\x0d\x53\x6e\x0a\x5c\xa2\xef\x25\x65\x8b\x9e\xf6\x28\x44\xf1\xd3\x6f\x4e\xbc\xfd\x6e\xb0\xf4\xc3\xff\x82\x8f\xbf\x2b\x6c\x27\xf7\x57\x51\x3e\x49\xff\x24\x5d\x36\x5d\x69\x63\xfd\x45\x94\xfe\xe9\xe8\x24\xeb\x79\xd0\xba\x7f\x78\x34\xd6\xe3\x17\x29\xa8\xd8\x3f\x3a\x58\x1c\x7a\xa3\x1e\xf0\xf8\x68\xb8\xb2\x2c\xef\x2e\xd0\xf8\xd3\x9b\x93\x72\xdc\xce\x5c\x77\x2e\xff\x5c\xf9\xf5\xc5\x9f\x2c\xaf\xa2\x55\x33\x24\x20\xdf\xe9\x36\x5e\x52\xb4\xd9\x7c\x6a\x6d\x5a\xd7\xb6\x3c\xb7\xf6\x47\x52\x7a\x69\x42\x4d\x12\x8d\xea\xc1\xa1\xf1\x89\x15\x1d\x51\xa1\xb3\x28\x16\x25\xa1\xf9\xfa\x8b\x96\x9a\x8c\x73\x93\x0f\x19\x77\x77\x34\x23\x06\x7c\xcc\x02\xda\xbd\x54\xc4\x27\xb4\xec\xc0\x19\x9a\xcb\x72\xcf\x4d\xf5\xb5\x40\x4f\xe9\xa8\xf3\x38\xea\x35\xf6\xd2\x8a\x3f\x86\x4c\x80\x0b\xc7\xa0\x6e\xb9\x9b\x61\x55\xaf\x1d\x26\x8d\xcb\x3f\x5b\x71\x4b\x92\xad\xe0\xf6\xf3\x03\xb3\xf0\x00\x7b\x8b\xba\x48\x42\x75\xed\x0c\x7e\xfa\x45\x2c\x89\xa8\x9b\xe5\x19\xeb\x3e\xc7\x6c\x89\xba\xbe\x1f\xda\xaf\x1e\x75\x83\x6a\xa5\x36\xdf\xf5\x4f\xba\xd7\x69\x3e\xfb\xd6\x1d\x8b\x1e\x5a\x04\xd3\x0f\x12\x07\x8e\x0d\x1c\xf8\x3b\x77\x5e\x35\x1a\x06\x70\x53\x8c\xd9\x60\xdb\x03\x74\xd5\x3f\xfa\x9e\xe3\x27\xbc\x24\xc6\x28\xb7\x1e\xf1\x6f\x31\x95\x71\x25\x89\x07\x39\x51\xb8\x72\xa3\xee\x51\x6d\xfa\xa4\x03\x1b\xd5\x1f\x95\x34\x5e\xc1\xab\x36\xf6\xe3\xfa\x6b\x56\xa2\x22\xbb\x58\xeb\x88\x10\x17\x93\xf7\x59\x43\xa3\xd2\x2a\x96\x67\xb3\x65\xe3\x79\x0a\x78\x91\xff\xb9\x2e\x2b\x5c\x2b\xaf\xb0\x87\xe6\x06\x18\x5b\x8f\xa5\x89\x57\x1f\xb8\x7e\x00\xdc\x48\xa4\x7b\x5b\x7e\x8e\xb3\x4c\x7d\x1c\x9d\xc1\x27\x69\x9e\x40\x2b\xe7\xa8\xfb\xf2\x55\xa9\xea\xf3\xef\x4b\xfd\xfd\x36\x9b\x7f\xb5\xbe\x8c\xbc\xb3\x04\xbd\x50\xe3\x66\x71\xdd\xf1\x82\xdd\xe9\xe2\xe2\xeb\x52\x7f\x9d\x35\xab\x70\xa5\x14\xb4\xee\x3e\xbb\x4e\x67\x69\x32\xfb\xc6\x75\x85\xc9\x38\x16\xe3\x95\xdd\xe6\x25\xd9\x30\xf1\xe2\x9b\x5f\x21\x07\x55\x98\x89\x0d\x10\x03\xc1\xf3\x43\x61\x57\x78\x47\xe8\x4b\x77\x85\xfe\x6f\x49\x79\x8d\x61\xc8\x38\x26\x2c\x46\x44\x8a\xb0\x54\xe1\x9a\x90\x71\xb1\x22\x4d\xad\x6a\xf0\xfe\x27\x54\x59\x00\xbe\x7a\x25\x07\x00\x00\x43\xb7\xff\x59\x7e\x6f\xe8\xbb\x08\x7d\x5d\xf2\xe1\x69\xe8\xd9\x9c\xd4\x13\x3c\x7f\xec\x21\x87\x88\x18\x87\x0b\xc5\x48\x81\x92\x4f\xf9\xc7\xf5\x35\x60\x19\x94\x35\x4a\x48\x81\x1b\x3c\x9b\x7f\xce\x4b\x74\x64\x2c\x68\x08\x8c\xa5\xbf\x89\x8a\xc1\x06\x15\x5a\xb6\xf0\x63\x0b\x3f\xf4\xa6\x71\xd7\x8f\x8b\x77\xac\xfa\x3a\x25\x0b\x37\x33\xf7\x52\xe7\x5e\xaa\xdc\xe6\x72\xe3\x98\x12\x94\x36\xac\x00\x9f\xdd\xb1\x02\xb9\x63\x41\xd3\x8f\x9d\x8f\x9b\x28\x25\xf5\xaa\x81\x82\x2f\xd4\x60\x55\xf0\x45\x87\x42\xe7\x71\x9d\x6c\x63\xc0\x7c\xc9\xcd\x41\x0a\x7f\xe0\x46\x13\x0f\xc6\x87\x6e\x00\x78\x8d\x05\xee\xa4\x6a\x42\xee\x3a\x77\x48\x6a\xeb\xe3\x24\xcd\x77\x3c\xe1\xbc\x5f\xe4\xa0\x9b\x60\xd9\x2a\x6d\x4d\xe0\xce\xd7\x2f\x8b\xbb\x8a\x2f\x12\x2c\x89\x58\xa6\x9d\x71\x11\x49\x84\x57\xb7\xfa\xa0\xe0\x2d\xa4\xf2\x55\xb8\x5c\x59\x99\x6a\x04\x95\x87\x9a\xe9\x78\xdc\xb5\xca\x62\x0b\xee\xc9\x64\xfc\xcf\x17\x0b\x55\x32\x20\xf3\xe3\x49\x76\xe5\x93\x90\x7b\x0f\x29\xea\x34\x3d\xe3\xdb\x2c\x18\xeb\x8f\xdb\x92\xa5\x37\x52\xda\x6f\x6c\x3d\x33\x78\xbc\xd0\xdd\xad\xbc\x88\x04\xcf\xb4\x5e\x75\x83\xbc\x8e\xb7\xd0\x16\x00\xc1\x02\xfd\xb6\x4f\x5b\x7c\x31\x07\xd2\x82\x37\xaa\x33\xbb\x61\x8e\xce\xd3\xb5\x79\xc0\xc9\xd2\xf0\x02\x4f\x14\x0e\xc0\x9b\x47\xce\x82\x4d\x7e\xc7\x0e\x12\x00\xc7\x47\x59\xdc\xc6\xfd\xa7\x8b\x2e\xfd\x31\x75\x29\x4d\x10\xf6\xf7\xcd\x1e\x9f\xa8\xb8\x09\xd4\x70\x8a\x20\x7d\xb2\x8f\x7b\x3d\x9c\xa2\x9f\xfa\x3e\xdc\x89\xf6\x24\x13\x60\xb4\x49\xa7\x53\xbb\xd9\xe0\x8e\x39\x0c\x5b\xa3\x60\x38\xf8\xee\x3b\xa0\xf2\x79\xa1\xfa\x7a\x24\x2f\x5d\x8f\xa4\x1f\x3f\xb3\x1e\xfe\x98\xd9\x0b\xc2\x7d\x0a\xfe\xe1\xd1\xbd\x74\xed\xb2\x97\xae\x5d\xd6\x2f\x7c\xa9\x8a\x5d\x67\xc3\x54\x68\x02\xee\xc1\x5d\x02\xe0\x69\xb1\x40\x8e\x42\x03\x1f\x06\x12\x68\x3c\xcc\xd1\x7e\x3b\x60\x56\xca\x62\x5c\x6d\x6a\xf5\x7a\x53\x54\xd3\x16\xb1\x60\x6a\xa9\x86\xd7\x65\x40\x39\x36\xfd\x75\xea\x0a\x76\xda\xda\x77\x68\x3d\xbe\xdb\xef\xdd\x89\x23\x99\x09\xa9\x99\xe8\x8c\x2e\xcb\xa9\x17\x0b\x73\xe9\xc6\x60\x78\x7e\xfb\x7b\xbf\xf5\xfa\x96\x44\x13\xc8\x17\xf1\xf4\x0c\xc3\x82\x61\x83\x38\x9a\xe7\xa7\x86\x65\x1c\x61\x42\x5a\x48\xfa\x63\x11\x4a\x0d\x3d\x32\xaf\x8b\x2d\x2b\x7f\x9b\x3e\x3a\x2c\x7f\xb6\x10\x57\x15\x25\x5f\x5a\x41\x61\xea\x88\x78\xbe\xbf\x73\xce\xfc\xf2\x0f\x6e\x83\xe1\xc1\xd8\x7e\x83\xe5\xec\x0a\x30\xb3\x46\xbc\x7b\xeb\xb6\xaa\x47\xbb\xb7\x14\x14\x2a\x60\xcf\x24\x0b\x5a\xd3\x5c\x28\x88\x5c\x20\x47\x2c\x79\x00\xa8\x7e\x57\xb7\xde\x18\x1a\x0e\x84\x9d\xcb\xae\x97\x1c\x37\xc0\x16\x72\x94\xcf\x2f\xaa\xa4\x12\x6a\x2a\x0e\xe5\x4b\xb7\xa3\x0c\x78\x94\x46\xf4\xbc\x52\xd6\x37\x85\xef\x0a\x56\xe3\x43\x46\x0d\x40\xd1\xa6\x13\x1a\x47\x83\xef\x85\x74\xc5\x1b\xf5\xd5\xbb\x7d\x1c\xf4\x2b\x7c\x9c\xb6\x93\x98\x43\xaa\xcf\x68\x53\x9b\xfa\x5d\x48\xc2\xe1\x9f\x18\xfe\x84\x32\xea\x40\x4d\x8b\x8c\x54\x9f\xf6\x86\x01\x27\xef\x1c\x31\x95\xac\xfd\x46\x66\xd5\xa5\xe0\x67\x28\x43\x25\x57\x9c\x17\xe0\xed\x2b\xbf\xed\x4f\x94\xe0\x03\x88\x69\x00\x52\x44\x45\x48\x88\x5b\x7b\xd2\x8c\x02\x19\x19\xf4\x69\x9b\x1f\x53\x6b\xbb\xa6\x74\x96\xfd\x21\xa5\xb3\xbd\x16\x2e\xd1\x32\x8a\xed\x0a\xbf\x8d\x0d\x2b\x26\xe1\xfe\xb1\x7d\x0d\x9f\x5f\x14\x33\x80\x93\xab\x44\x68\xa9\x09\x92\xed\x22\xba\x5b\x86\x95\x42\x5d\x6a\x65\x2e\xf2\x1c\xfe\x79\x59\x39\xe1\x7c\x9d\x68\x43\xb8\x80\x9a\x58\xc2\xe7\x22\xf5\x9c\xcf\xbc\xe4\xff\x58\x8e\x1e\x71\x51\xd1\x4e\x2e\x77\xe0\xde\x32\x9a\x14\x88\x05\x50\x85\x24\x75\xe0\x20\xd3\xaf\xf7\x02\xce\x02\x2b\x64\xdc\xac\xa9\xc1\xd6\xce\x45\xa4\xf8\x80\x93\xe9\x18\x09\x8f\x09\xd5\x47\x1d\xc8\x4d\xa8\xe1\x5d\xea\xe6\xa7\xe8\xd5\x71\x89\x48\xee\xa9\x0d\x33\x6d\x4b\xe4\x76\x84\x4b\x11\xe0\x8b\x6f\xe6\x9c\x6f\x0f\xb5\x2a\x23\x0c\xd7\x92\x4f\xcc\x8f\xf1\x5c\x8e\x8f\x3e\x85\x4f\xca\x75\x3b\xca\x23\xa2\x33\x58\x9a\xc8\x6e\xdd\x00\xbb\xd0\x76\xef\xb7\xb6\xa7\x52\x69\xd8\x5b\x2b\xe5\x45\x27\xe0\xb9\xb3\xc3\xf5\x4b\x80\x6a\xfd\x52\x88\x5a\x13\x38\xad\x9b\x97\x5a\xfd\x56\x33\xd7\xc1\x41\x8f\x0b\x4e\x80\x7c\x0e\xbe\xec\xe0\x9e\x34\xfc\x2b\x8d\x47\x1c\x0a\xd0\x1f\x8f\xe1\x8a\x88\x29\x0c\x16\x93\xbd\x47\x1e\x8d\xc2\xf4\x38\xca\xc9\xe2\x08\x35\x8b\x9c\x68\x1e\xf6\x0a\x40\x70\x39\xc9\x01\xa3\x00\xe9\x06\x48\xe5\x00\xf0\x78\xe9\xf7\xca\x83\x98\xf4\x41\xe1\xde\x30\x52\x46\x57\x66\x97\x89\xea\xb2\x68\x76\xf9\xf0\x74\x9f\xda\xd8\x09\xe7\x32\x07\x18\x98\x3b\x20\x7a\x0e\x0d\x2d\x64\xa4\xc3\xc9\xfc\xca\x1c\x5f\xc1\xbf\x03\x4a\x1f\xd5\xd2\xd1\x05\x5a\xb8\xe2\xd3\x5a\x58\xf0\x0a\xf7\xd0\x8a\xc5\x15\xf0\x5a\x6f\x70\xd9\xd7\x2b\xaf\xa6\xf5\xb9\xaa\xc7\xed\xe3\xec\xef\xba\xc9\xfe\x8a\x5b\x71\xc5\xf5\x65\xc2\xb5\x66\x73\x0d\x48\x8c\x26\xb4\x90\xb4\x76\xbb\xa5\x72\x02\xe4\xd9\x27\x62\x89\xee\x2a\x01\xc0\x4c\x04\xb9\x0b\x52\xa7\x23\xad\x43\xa0\x27\x9a\x0a\xfc\x4f\x05\xdc\xb7\xe4\x81\xf5\x50\xd5\x68\x3b\xee\x52\xaf\xe5\xba\x61\x6c\x90\x53\xf4\xbc\xaf\xa8\x85\xce\x7f\x4a\x37\x34\x13\x95\x17\xa8\xac\x2b\xf5\xba\x2c\x05\x2f\x2d\xdc\x33\x37\x5b\x5e\xc5\x0b\xf6\x15\x15\xbd\x28\x9c\x84\x48\xb8\xe4\x09\x5b\xa9\xab\x8c\x2e\x5c\xed\x41\x63\xdb\x6f\xd0\x03\x2a\x4a\xf7\xee\xad\x21\x6b\x12\x71\x74\x38\x84\x3e\xfa\xea\x5d\x42\xb2\x3c\xc2\xd7\xb7\x8c\x74\x69\xc4\xb8\xd4\xe6\x7b\x68\x01\x05\xd8\x5e\x3e\x04\xd0\x83\x43\x29\x1e\x1c\x04\x33\x9d\x4f\x52\xae\xf5\x0d\xec\xd1\x6f\x71\x9a\x70\x5f\x21\xde\x32\x00\xea\x40\xc6\x59\x55\xdc\x94\x0a\x58\x02\x64\x51\x18\x1f\xaf\x48\xa5\x70\x05\xc3\xa3\x98\x26\xda\x3b\x53\xd2\x3a\x4f\x6a\xbd\xa6\xc5\xa8\x88\x20\xcd\xea\x53\x08\x1e\x9c\xf9\xc2\x35\x69\xfd\x08\xb4\xc0\x07\xea\x7e\x7f\xe1\x6b\xbf\x59\x8e\xf2\x68\x4d\xc8\x43\x47\xcc\xe5\x3a\xf5\x7a\x6b\x3f\x8f\xe6\x18\x22\xa2\x36\xf3\xdc\x27\x3f\x56\xb9\x9e\x2a\xac\xb1\xfc\xda\xf6\x51\x97\xb0\x38\x28\x7a\xd9\x41\x16\xac\x30\x96\xa9\xb7\x8a\x12\x5c\x63\x58\xdc\x05\x60\xb4\x9c\x56\x82\x5f\xa1\x33\x7b\x30\x2a\xd8\x6e\x2a\xf5\xd7\x1f\x8a\xfc\x7e\xbc\x14\x61\x17\x28\xb6\xc2\x2c\xa0\x21\x8f\xcb\x5d\x73\x09\xcf\xb9\xf9\xef\xb3\x4b\x18\x09\x13\x73\x13\xb8\xd0\x65\x93\x61\xce\xe9\x5c\x4f\x58\x94\x5c\xba\x93\x56\xaf\x28\xec\x06\x30\x3b\x37\xf4\x9d\xc2\xda\x20\x91\xd1\x2f\x83\xa5\x73\x99\x73\x4e\xca\x79\x80\x3c\x25\x86\x50\x30\xb4\x74\xc3\xd9\x4a\x14\x40\xea\x41\x2c\x31\x51\x2e\xc7\xf2\x73\xb3\xbf\x9f\xf6\x46\xaf\x55\x7b\x82\x30\x58\xd2\xfb\x98\xa3\xc1\xb9\x61\x4b\x2a\x77\x50\xb5\x85\xe5\xd7\xc7\x43\x19\x21\xc4\x63\x3a\xcb\x3f\x5c\x87\x8b\x5e\x34\x3b\xf0\xe6\x6a\xaf\xd5\x90\xd0\xb7\xa3\x0c\x06\xc9\x5e\x9b\x43\x93\x8d\x61\x24\x91\xb4\xef\x1c\xd1\x56\x8d\x48\x55\xec\x6f\xdb\x46\xb4\x32\x4e\x98\x1a\xd1\xd6\x3d\x22\x32\x0a\xc9\xd1\xf5\xd5\x82\x7b\x51\x58\x01\x8e\x40\xd9\x9a\x19\xd0\xa4\x01\x7c\x85\x05\x7c\x99\x09\x7c\x53\x01\x7c\x49\x0d\xf8\xb4\xa2\x5a\x8b\xe9\x53\x0d\x10\x70\x52\x8a\x1e\xd3\x17\xba\x2d\x0d\xa8\x63\x6c\x2e\x84\xa0\x4b\x06\xa3\xf7\x8c\x14\x07\x61\x88\x50\x1d\xce\xac\xc8\x03\x21\x30\x22\x78\x9d\xa3\x06\x1b\x17\xf4\xf0\xfc\x0f\x39\x9e\x17\x19\xab\xb3\x0c\xcb\xd6\xb7\x28\xcb\xab\xf1\x89\x3a\x3f\xe6\x89\xf3\xf0\xf5\x73\x5c\x3f\x5a\x3a\x4b\x72\xde\x25\x3a\x45\x93\x7c\x14\x92\xca\xf7\x41\x39\xd0\x8b\xcc\x75\x80\x45\x5c\x39\xd7\x41\x4d\xfc\xd0\xd5\x52\xca\x29\xfe\x65\xd4\xc6\x41\x68\xf7\x5f\x8a\xe8\x0b\xe1\x82\xce\x49\x38\xa9\xd8\x09\x15\xdb\xb5\xc4\xb8\xa1\xa2\x9b\x94\xfb\x87\x9a\xea\x3c\x18\x88\x14\x3f\xe9\x25\x47\x21\x54\xe3\xbe\xb6\x10\x44\xdd\xf2\xa4\xe1\x27\x00\xc9\x03\xf3\x46\xaf\xe5\x21\x33\xe8\xb0\x9e\x35\x4b\x99\x84\x8b\x09\x66\xcd\xe6\xd8\x13\x5d\x31\xea\x4a\x6a\x1e\xd7\x4c\x40\x39\xe5\xaa\x96\xde\xa5\xdc\x32\xc2\x3b\xf9\xbb\x61\xa8\xed\x68\x69\x27\xe3\x39\xfb\x00\x70\x9d\x4a\xc9\xa2\x4a\x40\x5b\x04\xbb\xac\xe8\xe5\xfd\x5c\x96\x55\x09\xd1\xd0\x32\x86\x56\x6d\xe0\x55\x7f\xcc\x4e\x8a\x71\xdc\xa7\xc0\x92\xc3\xe3\x28\x33\xbd\x9c\x78\xf5\x31\x70\x97\x0f\x64\x62\xad\x27\xe3\x8d\xc4\xe5\x94\x47\xbd\x9e\xdd\x71\x50\x3e\x4f\x5b\x02\x3d\x6c\x8c\x34\xc2\xa8\x6a\x46\x9f\xbd\x88\x05\x38\x43\x23\xe9\xa4\x29\x5b\x18\x3b\x46\x65\xd4\x38\x44\x9b\x3f\x20\x04\x2c\x29\x53\x5d\xf0\x56\x6f\xc0\xda\x23\x21\x62\x35\x2d\xf4\xe1\x8c\x53\xd9\x6e\xf0\xc0\x7f\xb0\xf9\xd7\xb1\x79\x14\x64\xaa\x70\x95\x56\x3b\x19\x3b\xdf\x36\x24\x30\x5f\x1f\x24\x9c\x3f\x87\xa7\xd0\x14\xfe\x85\xb2\x5e\xb1\xff\xa3\x7e\x8b\x26\xca\xd3\xbe\xfb\x6a\x21\x6e\xf6\x86\xc2\x56\x43\x1d\x17\xd3\x48\x82\x52\x4c\x38\xb0\x40\x25\x2c\x0e\x22\xb6\x4b\x1a\x52\xfc\xa3\xef\xbf\x0f\xe4\x7f\xdd\x5e\x81\x0e\xbf\x83\x44\x8b\xf1\x9f\x16\x64\xdb\xb4\x7c\x23\xa2\x6e\x13\xed\xa0\xc0\x59\x5e\xbf\x2d\xce\x6f\x80\x0e\x94\xcf\x53\x43\xa9\x6b\x53\xb3\x70\x10\xc1\xcc\x46\x61\xfc\xba\x6c\x84\xbf\x48\xa3\xe7\x96\x5a\x7b\x55\xec\x06\xe5\x04\xa3\xd5\xa7\xc7\x39\x09\x58\x6c\xd4\xdc\x1c\x5b\x68\xee\xfb\xb2\x0f\xcc\x1c\x2c\xcf\xd1\x41\xde\x3b\xaa\x2d\x4c\x9b\xbe\x98\xd4\x83\x75\x08\x94\xd1\xc4\xbd\xe5\x1d\x0d\x35\x33\xd2\xad\x40\xfb\x6d\xf5\xfd\xfa\x1d\x20\xdd\xb1\x0f\x25\xe1\x56\xcb\x47\x33\x12\x49\xbb\xe0\x82\x26\x2a\x62\x20\xc5\x37\x71\xb7\xa6\xd7\x79\xd1\x68\x2f\x56\x34\xd3\xa7\xbf\x79\x0b\x1d\x69\xd1\x94\x00\xbd\x70\x5b\x16\x14\x36\x9e\xdc\xe5\xb6\x5c\x92\xb8\x40\xca\xb5\x64\x2d\x18\x97\xac\x3d\xab\x29\x9f\x88\x90\x70\x3c\x1b\xd8\xe3\xc7\x47\x0f\xfd\xf1\xd6\xe2\x75\xe1\x0b\xba\x0e\xf6\xd6\x32\x6a\x2b\x70\x9c\xec\x2f\xb1\x82\xc6\xcd\x02\xf3\xb4\xcd\x80\x97\x36\x2e\x6f\xfc\xc3\xab\x01\x76\x5c\x05\x6a\xb5\x82\x59\x30\x87\x2f\xce\x83\x25\x75\xe5\x5f\x87\xab\xa8\xba\x72\x96\x49\x6a\x34\xcc\x24\x6b\x3b\x86\xf2\x1a\x2e\xb3\xe6\x64\x57\x05\xb7\xef\xde\x08\x00\x91\xce\x20\x7c\x59\x54\x04\x11\xa6\xca\x3b\x91\x4c\x8d\x2a\x23\x02\xb3\x7a\xea\x68\xe3\x4a\x0a\xeb\xcd\xa3\xc6\x74\x14\xe8\x8f\xad\xea\xbb\x19\x96\x82\x50\xa1\x76\xd6\x03\xb4\x28\x70\x29\xb8\x50\x2a\x50\x60\xc9\x7d\xf6\x08\x1a\xb5\xa4\x08\xc1\xe2\xc0\x19\x70\xcc\x7d\xd6\x0b\x34\xa4\x23\xeb\x0c\xc3\x54\x3b\x02\xea\xf5\x52\xdd\x64\x2a\xfc\x8e\xc8\xae\x5e\xd6\xbe\xd0\x44\x93\xec\x86\xb5\x66\xb0\xbc\x2d\x8b\x89\x71\x33\x0c\x4a\x87\xdf\x68\x91\xfb\x28\x9e\x38\xce\x33\x46\x3b\x18\x03\x56\x32\x69\x76\x07\x1a\x16\x19\xc6\x08\xac\x6c\xb8\x53\x74\x0b\xb1\x6c\x21\x25\x1a\x9c\xf2\x63\x1f\xbd\x0c\xb8\xa9\x12\x5e\x11\x0a\x38\x38\x0f\xb4\x35\xb1\x28\xcb\xb6\xd3\xbd\xce\x1c\x44\x70\xbb\xcc\xdd\x59\xdc\x3c\x6e\xd2\xae\xa8\xc1\x2f\x38\x7d\xa3\x76\x7d\xc9\x7f\xe8\x39\x4b\xcc\x5d\xa3\x80\x1c\x4f\xc9\x8e\xe3\x57\x53\x8f\xac\xd1\x33\x73\xa6\x28\x9a\x9d\x04\x08\xbd\xd6\xf2\xb1\x46\xd3\x61\x4f\x5d\xf4\xfd\x91\x3f\xf6\xfe\xd9\x87\xe9\xa0\x4e\xea\x06\x4d\x84\x61\x72\x1d\xca\x4f\x9d\x01\xbf\xc6\x23\x8a\x0d\x53\xf4\xb6\xe0\xf0\x5d\xe2\x5a\x2d\xf5\x8c\xa4\xbb\x16\x7e\x6c\x1a\xa7\xe0\xf5\xc8\x97\xda\x9e\x35\x01\xfe\x13\x88\xa8\x32\x64\x6b\x4e\xbe\x97\x54\x3d\xad\x30\x5f\x74\xf1\xa1\xd0\x00\xae\xb9\xc1\x26\x72\x5d\xa6\x1b\x49\x89\x51\x19\x40\x17\xa1\x1c\x59\x63\xd6\x3f\xdb\x64\x5f\x1b\xa4\x58\x2b\x58\xf7\x0c\x64\x72\xc6\x6d\x0d\x68\x38\xb2\x6b\xa7\x39\x6c\x32\x37\xb1\x70\x1c\x48\xf9\xb6\x27\xae\x4f\x74\x7a\xfc\xe6\xfc\xb7\xa9\x32\xa6\x17\x00\x8c\x65\xce\x92\xf9\xaf\x84\x01\x78\x5b\xc6\x45\x45\x21\x2f\xea\x07\x24\x9e\xcf\xbf\x92\x5f\xd0\x86\x32\x63\x15\x56\x8d\x17\xd6\x93\x49\x3d\x85\x5e\x8e\xeb\x69\x18\x6e\xc4\xe5\xd1\xc3\xf4\x8c\xe0\x70\xfe\x82\x6e\x90\x48\x13\xdd\xeb\x72\x0f\xa5\x5d\xdf\xd3\x4f\xd3\x2e\xf7\x1d\x01\xb7\x91\x12\xba\x14\x86\x3c\x86\xcf\xc9\x8b\x9b\xe0\x2d\x8c\x3f\xde\xc0\x09\x71\x28\xc6\x2b\x77\x0c\x16\x99\x8b\x1e\xf6\x44\x3d\x29\xc2\x70\xe5\x8c\x6b\x2e\x22\x4f\x5a\x50\x9c\x11\x52\x93\x7b\x9e\x97\x4d\x94\x83\x9f\x64\x65\xcc\x16\xa9\xe3\x5a\x19\xa1\xe6\x28\x8b\xaa\x52\xee\xce\xcc\xf0\x9c\xf5\xde\xde\xa8\xbc\x67\x1a\x79\x62\xc4\x6f\x65\xd6\xd3\xa3\x15\xdf\xf6\x76\x48\xa0\x6c\x3e\xf7\x93\x1d\x28\x40\x54\xa8\x3c\x1c\x6a\xa3\x1d\x76\xaf\x98\x42\x6b\x33\x3d\xbf\xa6\xb9\x24\x1e\x48\xb5\xf6\x52\x50\x7b\x37\x65\xda\x10\xb6\x1e\x1a\x46\xea\x7c\xff\x51\xc3\xaf\xa6\x19\xaf\x24\xe2\x9b\x36\x68\x46\xba\x84\x6b\xd3\x00\xd6\x76\x4f\x6f\x30\x1b\xa2\x91\x46\x8b\x81\x6d\x72\x64\x18\xde\xda\x7a\xee\x9e\x8d\x35\xd4\xb5\x27\xb6\xc3\xd2\x60\x97\x2f\xd1\xd1\x04\xaf\x0b\x53\x84\x34\x92\x22\x24\x6d\x46\x0f\x27\xbc\xee\x7d\xcd\xdc\x3a\x7a\x23\x8e\x67\x95\xd4\xc9\x37\x31\x9b\x38\xc9\xa6\x0a\xbf\x1f\xd6\xb5\xc3\x29\xaa\x97\xab\x61\x2c\x03\x5c\xdd\x29\xd0\x0d\x31\xba\x5b\x68\x38\x7f\x6d\x36\xee\x9a\x24\x27\x15\x63\xc1\x46\x9b\x0f\x6d\x82\x2a\xaf\x81\x25\x8f\x86\x86\x9e\x7a\x3f\xa2\x43\xd8\x3e\xb9\xa4\xa1\xfb\xe7\x02\xb5\xfe\x39\xe1\x45\x86\x3e\x40\xff\xc8\x1b\xbb\x19\x9b\x48\x93\xeb\x5c\x13\x88\xff\xa3\x14\x10\xe0\x0a\x4f\x1b\x31\xf9\xbc\xf2\x18\x88\x42\x23\x60\x3b\x43\x0e\x51\x93\xcb\x31\x59\xab\xf0\x26\x44\xbc\xd5\x0a\x2f\x41\x24\x91\x7d\xad\xc9\xa2\x92\x1e\xca\x28\x46\x62\x1f\x79\x46\x41\x28\x07\x4b\xb8\x6f\x97\x66\xa3\x4b\xc5\xb8\x4e\x96\x57\xa4\x95\x80\xe4\xad\x96\xd4\xb1\xc9\x02\xed\xe0\xf0\x1f\x29\x69\x9b\x43\x21\xec\x40\x44\xcf\xc2\xf0\x50\xc2\x97\xc4\xfc\x84\x5b\x24\x60\x9c\xa5\x79\x34\x1c\x7b\xa9\xb7\xa4\xd8\x17\x24\x58\xe5\x79\x7b\x90\x97\x51\xfd\x9e\x97\xd3\xbf\x7d\xfe\xe9\x1f\x78\xde\x02\x7e\x53\x8c\xb1\x43\xc8\xdb\xf0\x3c\xfa\xf6\xc7\xd0\x06\x79\xda\x8f\x54\xfd\x71\x6e\x24\xf1\xa6\xc6\x43\x28\x18\xad\x44\x1c\x0c\x1c\x74\x58\xec\x45\x0b\x7c\x78\xed\x45\xf3\x80\x66\xb1\x46\x5f\x16\x8b\x80\xcf\x41\x86\xb0\x8a\xd6\xc1\xfa\x38\xa6\xe0\xa4\xe4\xa9\x07\x4b\xad\x5f\xa3\x78\x9d\x12\x86\x98\x50\x97\xe6\xda\xaa\x1e\xae\x00\x42\xe6\x0b\x9d\x7c\xbc\x0f\xc4\xd3\xf7\xc3\x0e\x18\x6f\xc3\x40\x8b\xa4\x03\xd1\x3a\x4c\x8e\xd1\x00\xa7\xdf\xf7\xa5\x78\xc3\x32\x9c\x49\x30\xac\x0b\x42\x0b\xe9\xa2\xa0\x8d\x84\x78\x3a\xa3\x97\x80\x2d\xbd\x04\x50\x53\x36\xc4\x71\xae\x31\x35\xf5\xe2\x52\x60\x05\xa1\xce\x71\x34\x53\xe1\x4a\x61\x89\xa1\x5e\xe1\x07\x22\xef\x75\xb4\x25\x89\x0d\xb0\x85\x56\x09\x43\x13\x0b\xb0\x5b\x70\x47\xfa\x70\xfb\xfb\xd3\xe3\x61\xe8\x4f\xfb\x7d\x48\x90\x91\x50\x27\x53\x72\x29\x29\x3e\x85\xfc\x47\xcd\x99\x83\xd3\x4d\x94\xf3\xe6\x44\x33\x37\xaf\x53\x23\xea\xeb\x4d\xaf\x67\xb6\x77\x43\xed\x21\x6f\xc5\x87\x02\x38\xf1\x66\x4f\xb6\x7c\x83\x3a\x0d\x09\xe9\x34\xc0\xb2\x5f\xc1\x11\x4b\x45\x74\x4a\xf8\xec\x8d\x04\x3b\xca\x97\x4d\x95\x1c\x06\xba\x3b\xbe\x98\x1b\xa7\x3c\x0c\xeb\xdc\x3a\xf0\x14\xba\xd3\xe6\xd4\xd0\x25\x37\x86\xf0\x50\xe7\xaf\xf5\xe5\x44\x86\x45\xdc\x00\xb6\x3a\x73\x34\xc7\x9f\x3e\x2e\x72\x8e\x5a\xa0\xad\x8d\x18\x29\x39\x3d\x79\xd1\x83\x0c\x0a\x99\xa3\x67\x8c\xa7\x36\x86\x80\x7d\x31\x29\x11\xca\xf1\x1f\x8a\xd0\x66\x23\x37\xef\x2c\xc0\x9c\xe0\x36\x78\x41\xe7\x22\x26\x46\x17\x95\x88\xe7\x93\xcd\x55\x74\x1b\xac\xd0\x8e\xe7\x4c\x3c\x75\xcb\x88\xfe\xab\x40\xea\x3e\x8d\xe7\x81\xf1\xd4\x30\x5e\x3a\x54\xdd\x39\xc2\x6e\x27\x28\xa4\xfd\x53\xfd\xbe\x0f\x5b\x02\xc4\x8d\x6c\x92\x82\x9b\x1d\x9f\xa6\xa9\x3c\xca\xca\x95\xad\x32\xb6\xf4\x2c\xe7\xcf\x03\x15\x0f\x3e\x1a\x7c\x7f\xf0\x07\x24\x6e\xa6\xcf\x8b\x97\x68\x8a\xd5\x89\x70\x7a\xf1\x93\x9a\x0c\x18\xda\x5d\x29\x90\x35\x3d\x4c\xc7\xe6\x67\x9b\xb3\xe9\xd0\x90\x13\xd5\xc5\xbf\x99\x12\x6d\x4a\xd9\x44\xad\xfe\x04\xc3\x8b\x5e\x99\xc8\x80\x0b\x93\x47\x61\xa9\x9d\xfd\x63\xbc\xe0\x96\xca\x25\x54\x16\x23\xb3\x50\x5c\xd9\x27\x2c\x6c\xed\x11\xb0\x34\x62\xa5\x3c\xac\x18\x24\xf0\xc7\x77\xb8\xca\x46\x10\x46\x35\xa4\xb0\x55\x9b\xae\xa8\x11\x94\xa8\xbe\x82\x54\xa3\xa7\xd5\x90\xb4\x9b\x0d\xc1\x46\x09\x5a\x41\x08\xbd\x2d\x27\xae\x35\xa5\xcf\xd4\x6e\x9c\xa1\x46\x10\xba\xec\x55\xb6\xea\x48\xf8\x09\x02\x84\x69\x79\x89\xe9\x5b\x14\x19\x25\x34\xf8\xc1\x65\x92\x8a\xc0\x4b\x4d\xa5\x48\xdf\xfc\x69\xbe\xf0\x9e\xaf\xde\xeb\x76\xfa\x1d\x75\x86\xc6\x9d\x6e\xcf\x5b\xf6\x2b\x48\x46\x25\xb3\xba\xa7\x48\x63\x70\x4d\x76\xb5\x4e\x58\x92\xb4\x83\x5e\x20\xba\x35\x7a\x97\x0b\x42\x3c\x3d\xbb\xa6\x20\x69\x4e\xb2\xa0\x97\x09\x9d\xea\x65\x6b\x02\xde\x56\x62\xd9\x36\x72\x08\x54\x05\xc9\xb1\x3e\x70\x7a\x7e\x6c\x91\xdf\x76\x30\xdc\x71\xbd\x83\x9d\x56\x00\xae\x2d\x07\x19\x01\x41\x65\xbe\x22\x01\xab\xaf\x09\x5f\xdc\x36\x2a\x7d\x9e\xcc\x1d\x95\x4d\x81\x8f\xe5\x0c\xc4\x70\x0b\xd4\x3d\x9a\xe3\x92\xbc\x50\xf4\x64\xea\xf5\xb6\x88\xfb\x5f\xb2\x29\x76\x39\x2d\xfd\xf3\x95\x1e\x2b\x0f\xbe\x99\x00\xf3\x41\xca\xf2\x76\x40\x4e\x85\x58\x42\x8b\xad\xd0\xf9\xc2\x53\x86\xf0\xa6\x50\x93\xfe\x99\x9c\x5e\x83\xd7\x97\x1a\x97\x61\xe3\xad\x9b\x4e\xb6\x14\xa7\x1a\xee\x65\x6b\xf6\x60\x5d\xad\x00\xdb\xf5\xd1\x81\xd9\x88\x26\xc5\x9e\x53\xc0\x65\x86\x6f\x4b\x97\x3e\xb4\xaf\x75\x7c\x29\x9b\xc7\xe1\x57\x4f\x40\xe6\x45\x0d\xbc\x17\x21\x24\x9f\xa4\x61\x45\xf4\xb0\x20\x38\x34\x82\x13\x14\x81\xf3\x76\x97\x5e\xfd\x63\xc9\x08\xf1\x81\xc4\x57\x11\x3d\x80\xdb\x78\xde\x9c\x67\x90\xa9\x57\x75\xe9\xa4\x56\x67\x63\x60\x75\x2d\xe9\x96\xaf\x86\xca\xb8\x48\x05\x71\x6f\x3a\x25\x15\x24\xed\x30\xcc\x8f\x13\x5a\xa4\xd6\xd5\x21\x8a\x6c\xc7\x1f\x1c\xf5\x9a\x98\x6b\x2c\x04\x83\x43\xee\xe8\xf2\x09\x71\xd3\x16\x50\x5c\x7c\xd2\x3d\xea\x8e\xbb\x18\x7c\x00\xc8\xd9\xa7\xe7\x1a\xd7\xe6\xaa\x8d\xff\x05\x0d\xd5\xf0\x45\x5c\xee\xef\x97\x83\xed\x11\x91\x9f\xf8\xc3\xa8\xff\x5c\xe3\x75\x60\x16\x5b\xdd\x26\x58\x74\x42\x45\x4d\xd6\xe7\x8e\xa7\xed\xb2\x90\x34\x3d\xc2\x3e\x67\x59\x7f\xd5\x76\xe8\xd4\x45\xea\xf6\x29\xa0\x2d\x10\x5d\x23\xe0\xfc\x22\x89\x3a\x7f\xc2\xb8\xa9\x90\x85\xf1\xf5\xa3\xf6\xc9\xba\x54\x30\x8d\x79\xa4\x4f\xb8\x7c\xa8\xbb\x25\x24\x9d\xfb\x27\xcb\x26\xd9\x2c\x5d\xcf\x19\x86\x56\xa3\xe2\xf3\xe7\x8a\xdb\x51\x98\xb0\x0e\x9e\xb3\xa4\x59\x8d\x13\xf5\xb2\x66\xea\x93\x39\x0b\x50\x70\x83\x51\x1d\x25\xd7\xa8\xc8\xad\xf6\x75\x4c\x41\x7c\xf6\x9e\xf0\xb7\xb1\x7d\xda\x2f\x32\x30\xe2\x14\x41\x48\x9e\xce\xa5\xa5\xde\x8f\x7c\xd0\x48\x78\xbe\x90\xbb\xb9\x0e\x66\xc0\x51\xa2\x5a\x29\x5e\x41\xf0\xcf\x1d\x6c\xc6\x9d\xa6\xed\xee\xc8\xa7\x6f\xdd\x98\x7f\x72\x77\x85\x63\x5d\x47\xd5\x04\x7f\x73\xe3\x16\xa1\xd3\x8f\xa1\xa1\x35\xea\x58\x07\x5b\x9f\xde\x8f\x45\xc1\x91\x2a\x08\x2c\xa9\x37\x15\x05\xe3\x8d\x37\x0b\xa6\xc0\x50\x84\xc0\xc6\xef\xa1\x0e\xed\x96\x48\xd2\x2d\xf7\x88\x3c\xa5\xa2\x43\x68\x08\x46\x18\x11\xc3\x4a\x39\xd3\x88\xc6\x4c\xb9\x23\x94\x40\x4f\xfb\x5b\x5a\x00\x7c\x6a\x43\x54\x36\x3d\x89\xb5\xa6\xee\xd4\x1f\x63\x39\x28\x26\xf5\x7d\x6e\x02\x52\x36\x24\x0f\x21\xcc\xbf\x89\xa6\xbd\xe2\x20\x0e\x36\xd1\x56\x23\x88\x5b\x5e\x9f\x6d\x56\xdc\x4c\x14\x49\xb2\xd8\x3f\x00\x6e\x19\x8a\x1f\xdc\x62\xe1\xc3\x5b\xf1\x04\xaa\xeb\xf7\xf1\x5f\x26\xdf\xbe\x1c\x96\x00\x46\x38\x40\xda\xf5\xe1\xf1\x66\x7f\x7f\xcb\x9f\x33\x37\x38\xb5\x1b\x9c\xff\xf0\x38\xc2\xa9\xdf\xe0\xd4\xc3\xfa\x5e\x6e\x82\x9b\x2b\x54\x8f\x58\x5a\x57\xd0\xd2\xe1\xe5\x39\x9a\x58\x65\x70\xb3\xec\x04\xd4\xfd\x97\xca\x24\x4b\x03\x9b\xf1\xd3\x78\x16\xe5\x56\x2a\x32\xa9\x27\xf6\x68\x30\xde\x7b\xad\x48\x70\x5a\xaf\x36\x6a\x56\x1b\xd5\xaa\x71\x65\xd1\x3d\xe6\xd3\x40\xe4\xb9\xf6\x9f\xd9\x89\xb3\x03\x60\x41\x4f\x0f\xa3\x5b\xe9\x4b\xf4\xb4\x7f\x16\x9c\xf5\xa3\xf8\xa0\x08\x4e\x7b\xf8\x4f\xe8\x5e\x96\xb3\xe0\xf4\x8a\xd7\x71\xe6\xdb\x83\x0d\x79\xa4\xfc\xe5\xa0\x8e\x13\xa2\x39\x01\xd3\xfd\x33\x17\x56\xaa\x2f\xac\xeb\xe8\x5e\x3f\x95\xc0\xd8\x2a\x6a\xe6\xda\x5b\xb6\xb8\xee\x5e\xba\x5d\x77\xbb\x9e\x56\x96\xc1\x3d\xa7\x80\x83\xec\xf1\x11\x2e\xaf\x25\x6a\x3f\x18\x4a\x4e\x19\x8f\x7f\xf2\x0a\x68\xcb\x9b\x64\x21\x9c\xfc\x74\xe8\x31\x60\xdc\x39\xad\x3a\x00\xa5\x25\x86\x40\x81\x65\xc4\xb0\x14\x70\xf0\x3b\x55\x0e\xff\xdc\xe1\xcb\x3b\xeb\x34\x11\x62\x87\x4f\xb8\x23\x83\xef\xcc\x07\xaf\x42\x53\x19\xe0\x29\xc4\x4e\x9b\xdd\x5c\x50\x7d\xd7\xfc\xb3\x6b\x1a\x5c\x44\x19\x5f\xd5\xe0\x2d\xfc\x72\xae\x5e\x3f\x6b\x59\xed\x37\x51\xcb\x7a\xf7\xdb\xf6\xe7\x03\x52\x7a\x9f\x61\xb2\x9f\x5f\x5f\x48\xc4\xf9\x59\xce\xf9\x3c\xf2\x2e\x26\x9f\x81\x48\x68\xeb\xd0\x3f\x7c\x1b\x7c\x8a\x5a\xda\xee\x9d\x1f\xbc\x09\x3f\x70\xc2\xf3\x93\xbf\xfb\xdf\x02\x2b\xc1\x07\x7f\x57\x17\xc9\xb0\x0a\x38\x0d\x94\x86\xff\x54\xe4\xb7\x22\x3a\xaf\x43\xd3\x99\x2c\x3b\x2b\xda\xc1\xcd\xa7\x1b\xaf\x8b\x21\xb1\x63\x44\x5d\x5d\x14\x02\x57\x93\x98\xf8\xfc\xee\x5b\xf5\x41\xee\x2f\x64\xe9\xc3\xae\x0f\x28\xef\xf1\xd1\x45\x33\x9c\xe0\xf5\x39\xfe\x0b\x9a\xd0\x4b\x3d\x95\xea\xb8\x3b\xfa\xeb\xbf\x0f\x87\xa3\xe1\xa8\x0b\x8d\x1e\x0d\xbf\xfb\xf7\xd1\xd1\x77\xa3\xee\x71\xc5\xef\xcd\xa1\x96\xe9\x50\x00\x54\x15\x30\xaf\x1e\xc0\xa2\x5e\xc0\x92\xd2\xb3\x13\x33\xd0\xd7\xc0\x0c\x5e\x1b\x59\xc1\x6b\xcd\x52\x08\xd1\x83\xcd\xc0\x0e\x10\x19\x39\x23\x6b\x3a\xaa\x71\xb0\x15\xc5\x2f\xe8\xc3\x55\xac\x19\x58\xb2\x35\x00\xb3\xd4\x5d\x70\x4d\xc2\x41\xb4\x59\x4b\xbf\x7b\xc1\xcc\x5c\xa4\xe7\xee\xc9\xa9\x65\x9c\x90\xa1\x03\xfe\xd2\xc9\x3d\x37\x8c\xda\xb6\xaa\xf7\xf5\xa6\x76\x32\x52\xac\x78\x48\xd1\x47\x35\x87\x6d\x54\xae\x3c\x93\xe1\x87\xb9\x29\x08\x13\x91\xdd\x01\x73\x76\xff\x91\x69\x07\x65\x0d\xe9\x8b\x0a\x5b\x0c\xd0\x8e\xbe\xb1\x72\xe4\xa6\x24\x54\x97\x70\x9f\x5b\x09\xdd\xff\x8b\xc0\x9c\x24\xb6\xf8\x3b\x94\xa8\x51\xca\xe1\x64\x50\x2c\x83\x0b\x01\xdc\x9d\x46\x23\x6b\x9d\x78\x61\xde\x17\x1f\x67\xe9\x70\xe6\x5e\x30\x5a\x05\x41\xe4\xfb\x5a\x6e\x87\xd6\x43\x68\x3b\x84\x6e\x8c\xa2\x27\xbc\x5d\x07\x6b\x2c\x32\x8b\xd2\x70\xf6\x5a\x1a\x49\x85\x33\x89\xcc\xb6\xd0\xff\x8c\xf0\xf6\x2a\x9a\x05\x48\x62\xe9\xe0\xf7\xdd\x7f\x83\x53\x4e\x91\xeb\x1f\xf8\xab\xc1\x56\x8d\x93\xdb\xc0\x28\x4d\x93\x23\x21\xf2\xba\x13\x76\x8e\x0b\xf9\x6a\xeb\x44\x3b\xde\x94\xdc\x89\xb7\xab\xf9\x9b\xd0\xdd\x45\x0e\x00\x31\xc1\x1d\xbe\xe7\x2c\x3d\xa5\xe8\xe0\xd7\x03\x41\xfa\x9c\x73\x45\x47\x68\x16\x97\x0f\x54\x24\x72\xae\x22\xc8\x3f\xa0\xa8\xe0\x68\x2f\x92\xae\xd2\x4e\x3c\x3b\x6e\xe6\xab\xb7\x9b\x15\xe9\x48\x77\x64\xc3\x71\xda\xe9\x02\xac\x1f\xc2\x6d\xd6\xed\x10\xb8\x96\xe8\x96\x06\xc0\xb2\x83\x52\xcc\x0e\xec\x53\xe7\x06\xa5\xb6\x9d\x98\x67\x77\xfe\xf1\xea\x55\x0f\xbb\xec\x75\x5f\x61\xec\x31\xd4\x98\x44\x19\xde\xa8\x37\x43\xd1\x9e\xf7\xaa\xdb\xdb\x42\x96\xdf\xb9\x5f\x26\xb3\x25\x86\x87\xc2\xc0\x65\xc2\xe0\x1d\xf5\xee\x6e\x07\x5d\x9c\x6e\x42\xef\x1c\xb0\x52\x63\xfe\xbb\x1a\xe8\x53\x3d\xa5\xe7\x2d\x24\xf5\x51\xf2\x6f\xa7\x8f\x78\xba\xa6\x00\x9f\xd7\x25\xa0\xa5\x93\x8b\xf6\x27\x58\x9e\xd1\xfe\x7e\x2d\x9e\xa8\x5a\x97\x18\xdd\x2c\x66\x31\xc5\x15\xf5\x68\xc2\x41\xa7\xac\xe6\xec\x6e\xe0\x03\xda\x49\x8a\xe6\xea\xd4\x16\x00\x49\x90\x38\xeb\xe4\xf3\x79\x87\x07\xd1\xc5\xb9\x8b\x85\xf5\xa0\x98\x36\x9c\x87\xd2\xfe\xb8\x23\xd6\xab\x2b\xbd\xdc\x19\x9b\x1b\x1d\xf9\x77\x13\x2f\x21\xaf\x7e\x8d\x15\xc2\x2d\x70\xad\x10\xa4\xf7\x5e\xba\x46\xa6\xd2\x46\x1b\x7c\x3d\xf0\xf7\x30\xb2\xde\x3e\xfc\x1f\x9d\x83\xff\x2a\x02\xd8\xde\xf8\x27\x7c\xe3\xb4\x5b\x09\x7a\xba\x1f\x7b\x71\x74\x23\xc1\x31\x84\x69\x7d\x17\x69\x70\xfc\xa7\xb6\xba\x91\x7e\x24\xa6\x37\x96\x9b\x78\x1f\x17\x99\xf7\xea\x3f\x96\x2c\xeb\xac\x51\xed\xba\xa3\x27\x16\xc8\xb5\x97\xc1\xc9\x58\x82\x6f\x1c\x9d\xeb\x34\xce\xbe\x61\x7c\x32\x60\xc7\xef\x43\x8c\x48\xc0\x80\xec\x4e\x16\xcb\x6e\xa7\x5a\xaf\x52\xdc\xac\x05\xc0\x6d\xf7\x55\xef\xa6\xf7\xaa\xab\x00\xfd\x15\xdf\x67\xdf\x50\x23\x75\xac\x19\x4d\xb3\x65\xbb\x48\xb1\xdf\x78\xc7\xc0\x83\xff\xba\x98\x14\x96\x3f\x05\x62\x56\x09\x2d\x88\xe4\xbd\x68\xde\x80\xd9\x5f\x14\x78\x71\xef\x86\x04\x9a\x02\x1a\x67\x78\x14\xbb\x3d\x59\x1d\x20\xad\x33\xcf\x19\x3f\x8b\xf1\xa2\x60\x8c\x07\xcb\xd5\x10\xca\xd1\x2a\xd6\x99\x63\x61\x00\x4a\xce\x9b\xcf\xa4\x2f\x2a\x1b\xfb\x72\xb0\xd8\xa0\x20\x55\x4a\xd4\x80\x23\x4c\x5e\xdf\xd5\x97\x81\x18\xc4\xbd\x11\x4d\x7b\xe3\x3f\x58\x3b\xd6\xbd\x00\x12\x5d\x44\x4e\x2c\x3b\xaf\x78\xdb\xaf\x24\x85\x0e\xd8\x02\x08\x9f\x80\xce\x18\xd2\xf2\xe4\x36\xb8\x03\xcc\x00\x0e\x57\x9d\x40\x8d\x6f\xd0\x8b\x21\x2c\x38\xd2\xff\x71\x5a\xe6\x18\x31\x18\x3d\xa0\x8b\x99\x0d\x3a\xff\x91\xa4\x69\x67\x5e\xe4\x2b\x6a\x0d\x1b\x10\x73\x8e\x01\xb9\xad\x4b\xce\x30\x88\xbe\x45\x1d\xb8\xfc\xb0\x95\x24\x5b\xb3\xdd\xae\xe0\x54\xed\x1d\x12\x9f\x82\x86\x40\xe1\x81\x3d\x23\x6c\x16\x06\x9e\xaf\x09\xe5\xe5\xc5\x1c\xa0\x8a\xff\xd3\x49\x2a\x18\x52\x81\xee\xf1\xd2\x2d\x0e\xb2\x5c\x31\xc0\x37\xa8\x90\x07\x10\x8b\x38\xb1\x18\x94\x79\x61\x44\x62\xb6\x22\x9e\x01\x50\xf4\x91\x60\xde\x01\xef\x50\x38\xe8\x88\xd3\xa2\x88\xb7\xd3\xba\xfe\x06\x8f\x99\x6b\x07\x64\xaa\x47\x52\x8e\xb3\x57\x55\x07\x29\xed\x0e\xbb\x5d\x55\x5b\xbe\xac\xb0\xf2\x5d\x2e\x9b\x97\xa1\xab\x98\x8e\x3e\xd1\xd2\xd2\x99\xa8\xd8\x99\x89\x60\x95\x62\x03\x00\x1b\xf2\x96\x31\x7a\xa0\x68\x94\xa8\x1c\x23\x52\x90\x1b\xc6\x10\xb4\xec\xf5\x25\xab\x8a\x8e\x88\xed\xa8\xb6\xf6\x9c\xc9\xdf\x1d\xe0\xb7\xd3\x64\x96\xe0\x1a\xdf\x25\xb1\x06\x2a\x22\x5a\xe4\xfe\x1a\xf1\x0b\xbb\xbe\x8b\x6a\x99\x74\xbf\x76\x51\x18\x8e\x96\x26\xc6\xc4\x49\xe4\xdb\x2c\xce\x01\xa3\x7b\xd9\x45\x87\xab\xcf\x91\x38\xda\x78\x30\x71\x3f\x72\xa2\xb8\x4e\x9d\xfa\xe7\x57\xfd\x63\x52\x02\x75\x09\x37\xed\x35\xab\xee\x19\xcb\x9c\x67\x3a\xc1\x33\x8d\x70\x9e\xb9\x70\x46\x8c\xb0\x83\xc5\x8c\xde\xd0\x1e\x88\xef\xd5\x4e\xd8\x76\xe0\x53\xdf\x87\xe4\x1b\xf3\x18\x77\x0f\xe3\x8b\x57\xb8\xff\x97\xb2\x14\xca\xef\xe2\x2c\xcd\x33\x8c\xe8\x15\x6a\x59\xbe\xa5\xdb\x2b\xcf\x49\x81\xa2\xf1\x27\x97\xfa\x0b\xe0\x1e\xba\xe8\x63\xbc\xe8\x61\x0d\xe9\xa8\xc0\x71\x27\xf0\x36\xce\x8b\x00\x6a\x6c\x11\x16\x0a\xc8\x73\x79\x2c\xb5\xfb\x1f\x91\x87\x97\x32\x2a\x76\x49\xa6\xb2\x96\x8c\x6e\x62\xdc\x63\xd9\x08\x6a\x0c\xb6\xaf\xb0\x86\x25\x08\xae\x98\x9e\x66\xe5\x98\x44\xb3\x51\xa3\x79\x15\xd2\x68\x57\x17\xf2\xff\x1f\xe6\xa5\x06\x1c\x52\x9b\xfb\x1a\x30\x07\xf6\x43\xd4\x73\x81\xb1\x6f\x5d\x96\x9e\x4d\x31\x00\xa7\xd4\x29\xf4\x06\xbe\x6f\xbe\xc9\xe7\xcc\xfb\xe1\xfb\x5e\xf5\xa7\xa3\x1f\xc4\xa3\x18\x17\xde\xdd\xa4\x39\x7e\x1c\x62\x72\x85\x6f\x83\xad\x55\xbd\x0a\xe8\x39\xac\xde\x43\x77\xe7\x1f\xf2\x7b\x56\xbc\x89\x4b\x7a\x64\x37\x9b\xa2\x62\xd4\x9c\x54\x00\xde\x05\x18\x0e\x84\x4b\xce\x61\xbe\x9f\x6e\x94\x8e\x23\x45\x03\x31\x32\x78\xcc\x40\x60\x09\xa5\x23\x66\x28\x86\xac\x87\xc7\x7d\x40\xa1\x8e\x30\x6a\xb9\x21\x37\x88\x3f\x2b\xd8\xdf\x2e\x17\xa0\xfd\x7f\x5d\x3a\xc0\xc5\xdb\x38\x47\x0e\x14\x70\x8a\xb2\x96\x63\x91\x67\x80\xf8\x5f\x61\x33\xaf\x02\xfe\x2f\xae\xc3\x2b\xc2\x7d\xaf\x78\xed\x57\x1d\x84\x1b\xb8\xf6\x0b\xd6\x29\xd7\xab\x15\xdc\xb9\xc8\x0f\x01\x29\xc8\x51\x62\x67\x84\x47\x5b\x41\x54\x87\xdc\x2d\x77\xbc\x77\x70\xa8\x80\xd6\xc8\x90\xd6\x90\xc7\xea\xff\x07\x32\x8b\x9d\x76\x13\x34\xb9\x02\xa6\x16\xa3\x99\xa3\xd5\x98\xf4\xb2\x93\x1c\xe7\x3a\x92\xf8\xa2\x01\x9b\x39\x87\x4d\xb1\x6f\x51\xb4\xf0\x53\x7e\x5b\xe6\xbe\x62\x4e\xd0\xa6\x0e\xce\x14\xe6\xea\xf7\xfd\x1a\xa7\x22\x9c\x3e\x9f\x22\x61\x41\x32\x60\x45\x83\xce\xa3\x74\x62\x6a\xcb\x2d\xeb\xaf\x3f\x73\xff\x64\x39\x99\x5f\xc9\x6e\xc7\xf8\x11\x4d\x72\x7c\xa6\xdb\x1b\x72\x32\xbe\x06\x44\x9f\x08\x88\x24\xb4\x20\x1d\xaa\xc0\x04\x99\xbb\x8e\x12\xff\x12\x24\x71\x4a\xfa\xdd\x5d\xf2\xfb\xa0\xf3\x8a\x4f\x85\x2a\x11\x28\xea\x9a\x09\x5c\x17\x8d\x49\x60\xb9\xaa\x40\x6f\xf4\xc2\x33\xd0\xc4\x58\x40\xda\x2a\x38\xdd\x57\x62\xb1\xd1\xf5\x47\x6a\x46\x6f\x5f\xf3\x39\x35\xaa\xa4\x30\x3b\xbf\xd5\xf2\xc9\x60\x8c\x51\x43\xb1\x17\x8d\xfc\xd0\x41\x02\xad\x01\x31\xad\xd5\xb3\x1a\xe9\x73\x4e\x50\x9b\x73\x6f\x14\xa8\xc0\xfb\x38\xa6\xc2\x04\x00\x29\x21\x71\x47\xb4\x95\xd1\xc3\xbc\x3c\x18\x6a\xe5\xe0\x5a\x3a\x37\x72\x16\x24\x55\x0b\x4e\x3b\xa9\xd5\xd1\xf7\xd9\xb8\xde\x5a\xf0\x42\xf1\x40\x89\x8a\x14\xfd\xd1\x71\x49\xea\x61\xa2\xff\x89\xd5\xda\xa8\x77\x74\x50\xfa\x81\x95\x76\x44\x69\x57\xbe\x7e\xa5\xc4\x86\x4a\xbd\x4f\xa5\x66\xa1\xd3\x49\x49\x8b\x73\x67\x6c\x9b\x6c\xe7\x06\x46\xba\x42\x3f\x0f\x35\xe0\xbd\x69\x59\xa7\x25\x6a\x9d\x12\x0d\xc6\xf9\xb0\x87\x5d\xb8\x11\x4f\xba\x51\x03\x1e\x6e\xfc\x60\x43\x2a\xcb\x11\x32\x9b\xf0\xbb\x5c\x02\x4c\x5e\xb0\x4d\x15\xc5\x4a\x1e\x81\x65\x50\x7d\x27\xea\x76\x95\x10\xee\x16\xe6\x72\xfb\x1a\xfb\x92\xd3\xb9\x85\xe9\xdc\x22\x4f\x47\x65\x7b\x11\x17\x3c\xca\xaf\xe6\x10\x6f\x61\x69\x84\xbe\x3b\x1a\xb6\xea\x05\xba\x33\x17\x28\x29\x7f\x42\x7d\x48\xe6\xdd\xa1\x0e\xdd\xe3\x23\xfd\x2b\x42\x9c\xcc\xea\xbc\xf2\x6c\x32\xab\xf3\xca\x5b\xe2\x95\x67\x8a\x41\xb3\x0e\x35\x67\x1c\xde\x2f\xb2\x1c\x8f\xa6\xa0\x96\x72\x4e\xc0\x69\x2c\x7f\xcd\x66\x31\x32\x81\x90\xa8\x60\x17\xc5\x03\x38\x08\xc1\x7a\xf2\xeb\x01\x38\xb5\xdd\xb6\xc1\xf7\x19\xd7\xc5\x3f\xc9\xfd\xcd\x5e\xca\xfd\xd9\x96\x5a\xb3\x60\x6a\x2c\x90\x7c\x4f\x30\x50\x8c\x37\x0d\xf6\x86\xcf\x4b\x5e\x6b\xca\x15\xad\x86\x5e\x8e\x90\x45\x35\xbd\x31\xb3\x0e\x59\x36\xd6\x1e\x33\xd0\x31\x78\x5b\xa8\x90\x9b\x04\x48\x38\x42\x20\x8a\x62\x56\xd1\x6f\x18\xbd\x9a\x70\xdb\x17\x60\x47\x88\xe1\xe5\xfc\x88\xef\xb7\x59\xaf\x71\xae\x58\xa9\x35\xfe\x11\xfb\x35\x7d\x3d\xd5\xc3\xf0\xc0\x40\x5c\xc3\xd3\xa7\x8e\x3f\x46\xeb\x51\x68\xea\xf4\x5f\x1c\x89\xba\x28\x65\x9f\x3a\x52\x8f\xfb\x95\x20\x24\xad\x7c\x61\x11\xa8\x2d\xff\x30\x47\xb3\xa1\x61\x12\xf1\xd0\x46\x83\xaf\x1f\x3f\xfc\x5c\x55\xab\x2f\xec\x7f\xad\x59\x59\x9d\xa0\x82\xa7\x9d\x44\xae\xf4\x4f\xf1\xe9\x94\x7d\xfd\x44\xcb\x82\x0c\xe8\xac\xc8\xcb\xfc\xa6\xa2\xea\x17\x17\x9f\xbb\x56\x74\x84\x64\x90\x67\xa4\x46\x07\x1b\x0f\x43\x6c\x04\x47\xf8\x33\xea\x7f\x50\x81\x73\x2c\x00\x9b\x7c\x34\xe4\x4a\x21\x58\x61\x5d\x3e\x3e\x9a\x5f\xe4\xff\xd7\x9a\x0a\xd9\xca\xaf\x00\xd0\x19\x22\x34\x00\x36\xe8\x10\xae\x67\xaf\xfb\xee\xed\x45\x37\x60\x04\xfc\x50\x1b\x2e\x6c\x8f\x23\x14\xe7\x95\xff\x6b\xf6\x2d\xcb\xef\x33\xce\xd1\xdd\x10\xf1\x83\x9a\xa9\x6a\x9d\x5d\xae\x4b\xc4\x73\x5c\xcd\xc8\xa2\x3d\x70\xeb\xde\x48\xda\xf7\x50\x38\x1f\x46\x6e\xe6\x6e\xe3\xd5\x07\xb6\x88\x67\x5b\xe3\xf5\x2f\xec\x6a\x93\x22\x7c\x6d\x29\xcc\x58\x14\xc2\xd2\x28\x2a\x8c\x2f\x3f\xe8\x6a\x95\x6c\xab\x86\xe9\xd9\xaf\x30\xbe\x82\x2e\xf7\xe2\xcf\xe6\x84\x18\x19\x5c\x38\x8b\x22\xbe\x45\xf7\x88\x33\x4c\xa7\x46\xe4\x33\x87\xd6\x25\x96\x4e\x16\x8d\x96\xe0\x38\x9a\xca\x6c\xbc\xf3\x3d\xef\x25\xed\xab\x18\x1b\xda\x1f\xad\xea\xc0\xd0\xea\x90\x1a\xe1\x18\x37\x00\xe3\x42\xbc\x41\x18\x52\xcf\x5b\x1f\x92\xb2\xf2\x5c\x82\x14\xf4\x98\x2c\xd5\x13\xcf\x18\x5b\x79\xf5\x47\xab\xa0\x78\x1e\x2d\x06\xc9\x89\xd7\x8a\xe6\x50\xa2\x68\xe0\xb9\x40\xa3\xb0\x28\x09\x62\x69\xb8\x4c\xf8\x0e\xb0\xd6\x38\x46\x0d\x87\x93\xda\xe1\x1e\x37\x95\xb0\x01\x4e\x08\xde\x1a\xa0\xe1\xe0\x64\x8d\x10\x49\x31\x8f\x1a\x56\x35\x03\xf3\x23\xbe\x82\x51\x01\x29\x86\x31\xea\x5c\xf9\x64\xdd\x4c\x56\x59\x12\x44\x8d\x7b\x48\x84\xc3\x40\xf6\x00\x66\xc0\x7f\x44\x38\x5f\xfe\x73\x52\x5d\xa9\x64\xf8\x6d\xe7\x60\xb3\xc9\xce\x0c\x8f\x10\x73\xef\xa3\x4e\x97\xc8\x50\xba\x71\xb3\xf2\xc9\xc3\x59\x8c\xb9\x70\x03\x88\x6f\xb8\x45\x49\x73\xa3\xf3\xab\x90\xda\x12\x5b\x26\x65\xb7\xc8\xc9\xf1\xc4\x02\x12\x49\x2d\x24\xc9\xca\x0a\x30\xcc\xa0\x83\x44\xd7\xa0\xf3\xd0\xc1\xd1\x75\xc6\xf0\x83\x17\xe2\xbf\x32\xfa\x35\x18\x0c\x3a\x3b\xfa\x9f\x57\x32\xd6\x59\x02\xf2\x1b\x1f\x1e\x4a\x29\x35\x6a\x2b\x1c\xc2\xba\xf5\xb1\xdd\xc1\xb2\xba\x4d\xa9\xbf\x5b\x00\x71\xe8\x85\x23\x0e\x18\x04\x5e\xe8\x89\x47\x8e\x7f\x71\x4d\x65\xf8\x09\x5c\x65\x0c\xda\x26\x64\x2e\xf2\x29\x50\x31\x5b\x14\xa8\x58\x1c\x27\x9d\x88\x41\xdc\xba\x2b\x84\x7c\x0c\x8d\xf2\x95\x68\x3a\x51\x52\xa5\xf2\x44\x2a\xb9\x69\x32\xd0\xa2\x74\x93\xff\x13\x35\x38\x97\x2e\x4a\x29\xa5\x14\xc8\xd9\x36\xc6\xb7\x7d\x6e\x7c\x97\x72\x7c\xdb\x96\xf1\x6d\x9d\xe3\xdb\x3e\x31\xbe\xad\x1a\xdf\xb6\x36\x3e\x3c\x44\x5f\xdf\x15\xc9\x5c\x8c\x9d\x9b\x15\xc0\xa7\xce\xc5\xde\x8c\x5c\xfa\x54\xb9\x97\xa2\xee\xd6\x59\xf7\x52\xd4\xdd\x36\xea\xea\x35\x16\x9e\x60\xec\xf5\xe5\x89\xb5\xd9\xca\x92\x5b\x57\x49\xe6\x8a\x3b\x5a\xf3\x7c\xae\x9c\x23\xf0\xdc\x29\xda\xf8\x4b\xc3\x7d\x9d\x82\x5a\x9c\x52\xa8\xbe\x17\xe9\x88\xda\x4d\x92\x95\x83\xb4\x0a\x9b\xda\x01\xae\x60\x9d\xce\xf1\x8d\x0c\xcd\x19\x80\x3a\x25\xc6\xf6\x77\x56\xe4\x5a\x5c\x5e\x22\x4d\x5c\xdd\xe7\x9d\x2c\xcf\xfa\xbf\xfc\xfa\xe1\x83\x91\x35\xe8\x7c\x06\xc8\x06\x4c\x86\x64\x36\xd0\xbb\xf7\xc0\x2c\x63\x6d\x3c\x0c\x55\xc4\x23\xb9\x58\xe6\x46\xc2\x1e\x20\xb1\x82\xd2\x85\xd5\x89\x1d\x29\xd1\x0e\x6f\x67\xc4\xac\xab\x15\x10\x01\xee\x98\x51\x82\x77\x10\x55\x96\xc9\x01\xac\x85\xd0\x36\x91\xd9\x56\x63\xb3\x34\x01\x14\x4f\xfb\x62\x57\x73\x15\xfb\x99\xf2\xfc\xc0\x8b\xf7\xcc\x29\x3d\x3e\x26\x7b\xd6\xa4\x74\x34\x29\x77\x7c\x64\x7d\x1f\x58\x11\x24\xc5\x86\x8e\x76\x75\x67\x0a\x32\x9a\x94\xc3\x85\x83\x41\x22\x54\x4f\xfb\x64\xd0\x16\x4e\x26\x3d\xa6\x6c\x02\x0c\x67\x68\xba\x24\x86\xcd\xb3\x54\x75\x8c\x46\x26\x86\x31\xa0\xab\xa6\x54\xa7\x68\x58\x73\xa3\x4d\xa5\xbb\x4d\xce\xd1\xa1\x97\x7b\x53\x3c\xdd\xd2\x7e\x43\x69\xea\x37\xc7\x04\xeb\x11\x2d\x4c\x33\xaf\x50\x04\x30\xd7\xef\xd5\xf6\x89\x49\xb2\x3b\xf4\x09\xda\xe1\x7c\xb6\x7c\x4b\x81\x5b\xd7\xea\x8b\xe8\x46\x00\xb1\x18\x2f\x43\xd6\xd8\xdf\xda\x28\xad\x63\x2e\x19\xbe\x07\x02\xa3\xb1\x79\x48\x38\x20\x8d\x4d\xa0\x6a\x3a\x80\x32\xd8\xbe\x1a\x55\xaa\x22\x51\x52\x70\x1f\x59\xea\xcb\x3a\x55\x76\x60\xb1\xae\x3b\x95\xc3\x16\x66\x4b\x27\x5e\xdd\x3c\xcd\xe4\x2f\x1b\xb5\x85\x3f\x7d\x49\x00\x19\x73\xf7\xc7\xa4\xd8\x59\x7b\x04\x86\xc5\x9c\x13\x4b\x6c\xb5\x0b\x78\xe8\x06\x6f\x55\x71\xf3\x12\x36\x21\x36\x61\xd0\xb9\x28\xb6\x58\x98\x74\x34\xe0\xa6\xbf\xa5\x37\x2c\x9e\x09\x68\x8c\xe2\xd0\xe1\x9b\xa0\x7e\x5b\xa6\x5b\x1b\xb5\x16\xca\x43\x3d\x50\xba\xbd\xeb\x50\x13\xbb\x56\xb0\x66\xae\x60\x4c\xb5\xe9\x4b\x93\x0b\xcf\x9c\xa1\xb5\x1a\x2f\x7d\xb2\xd5\x93\x4a\x98\x04\xfb\x14\xb4\xac\xe6\xef\x05\xd5\xb2\x48\xbd\x88\xbb\x7f\x6d\x35\xa3\xa8\x5b\x33\x56\x57\xf5\x3b\x05\xd6\xa7\x89\x30\xea\xb6\xa8\x27\x75\xc3\x27\xb2\x21\x02\x3a\xb5\x66\xe4\x46\x0b\xd7\x80\xa6\x86\x33\x1f\x2c\xc2\x8c\x42\x6f\xce\xcf\x25\xed\xda\xbd\x26\xd1\xc8\xb8\x33\x5a\x6d\x3a\x00\x10\x00\x19\xd7\x69\x3c\xfb\x16\x76\x74\x30\xd4\x3e\x0f\xc8\xd8\xa1\x68\xa8\x61\x07\xc5\x4c\xfd\x18\x83\xf1\x8f\x3b\x42\x67\xa3\x1b\xc4\xad\x91\x12\xe9\x62\x40\x15\x4f\x23\x3c\xe2\xe1\xac\x84\x3b\x5d\xd5\x40\x53\x69\x81\x8d\x7f\xdc\x5e\xc4\x64\xa3\xe8\x75\x97\x0c\x4d\x16\xf0\xa5\xcc\x8c\x8d\x18\xd7\xa3\x7d\xa8\x56\xa8\xa3\xf3\x25\x63\xda\xd5\x90\x08\x52\xb9\xe7\x2a\x83\x0a\x5b\xf3\xa4\x44\x91\xc0\x9c\xaf\x46\x11\xb5\x94\x0b\xab\x62\xfb\x40\x2a\x23\x40\xc4\x02\xbd\x8e\x8b\x2c\x7d\xa9\x15\x03\x98\x0b\x26\x94\x27\xfa\xa7\xe8\x7f\x3c\x0c\xcd\x2a\x5e\x77\x20\x0e\x83\x70\x94\xa2\x77\x84\x68\x5f\xb8\x35\x3b\xbb\x2e\x7a\x73\x27\xee\x18\x23\x16\xce\xb1\x1e\xb2\xdb\xe2\xe7\x13\x4d\xa0\x7d\x60\x68\xf9\x31\x75\x6c\x3b\xca\xe8\x76\x33\x7c\xaa\xf6\x72\xff\x61\xb7\xab\x3d\xec\x67\x24\x3d\x43\xe5\x8d\xf9\x5c\x3d\xf0\xeb\xb3\xd6\x81\x16\x3a\x05\x0c\x23\x94\x62\xf4\xce\x2d\xfc\x77\x8d\x12\xbb\x9b\x01\x37\x1c\xdd\x01\x0a\xdf\x33\x00\xb0\x8b\x02\x3d\x94\x99\xcc\xaa\x6e\x28\xc9\x9d\x0f\x9f\xde\x4d\xcf\xdf\x9c\x7e\x78\x8b\x1e\x60\x55\xe2\x2f\xd3\x8b\xb7\xbf\x44\x4a\x51\xbf\x51\xd8\x57\x45\x21\x7b\x34\x74\x1d\x42\x55\xb9\xf2\x0f\xed\x76\x77\xaa\xf2\xd9\xa7\x8b\x8b\xb7\x67\xd3\x0f\xef\x7f\x79\x1b\x4d\x8e\x82\xa3\x2b\x9d\x73\x7a\xfe\xb3\xca\xf9\xf7\xe0\xbb\x2b\xb3\xce\x14\x73\x55\xde\x51\x60\xd5\xd4\x96\x97\x4e\xd4\xd0\xb0\xcc\xd4\xa3\x81\x95\x26\x7e\xb6\xe9\x2e\x50\x65\x21\x77\xcd\x32\x56\x9c\x34\x93\x28\x96\xc4\x1e\xb9\x1d\x9b\xb0\x1e\xf0\x79\x66\x30\x7e\x11\x2c\x9b\xbb\x88\xe6\xa8\xa2\xaa\xe2\xd9\x92\x07\x94\xeb\x02\xc8\xf4\xe0\x8e\xa1\x7a\xbe\x31\x22\xcb\x61\x94\x15\x84\xae\x31\xc6\xfa\x24\x44\xba\x24\x9f\x16\x38\xca\x42\x45\x13\xe7\x68\xec\x01\xd8\xb0\xdb\x31\x90\x45\xd0\xc3\x98\x05\x37\xd9\x38\xde\x19\xbd\x1b\xc1\xbe\x1b\xdd\x91\x12\x84\x51\x40\xae\x82\xef\x4c\x95\x6b\xc3\xa5\x6c\x78\x84\x49\x42\xd7\xb2\x00\xe2\x54\x00\x97\xbd\x13\x4b\x49\xd7\x80\x6b\x59\x5c\x91\xd2\x5d\xf1\xaa\x1b\x0b\xe0\x3b\x7c\xa4\x35\x57\xc9\x70\x93\x66\x8a\x65\x1b\x05\xe1\x72\x09\x1d\xab\x06\xec\x35\xae\x30\xb0\xff\x38\x58\xf8\xe7\x26\x13\x4e\x6e\x1a\x2d\x00\xb5\xa8\xe7\xc7\xe3\x56\xd6\x17\x5e\xc3\x70\x04\x57\xe4\xd8\x84\x28\xf2\x07\x91\xaf\x50\x86\x11\x2f\x08\x3f\xa0\x60\xa3\x96\x44\x11\xe4\xed\x98\x9d\xdc\xd7\xaa\x1d\xc5\x33\xa8\xea\x31\x3b\x55\x0a\xff\x5d\x0b\xf1\x09\xe4\xb8\x1a\xb8\x8c\xac\xdc\x00\x17\xe5\x6a\x45\xa9\x4f\xf9\x49\x14\xa3\x83\x84\x20\x8b\x62\x2d\x7c\xcd\x4d\xed\x80\x1f\x0e\x2a\x54\xe2\x86\x7f\xfa\x18\x8b\x20\xc6\x40\x22\x0c\x35\x89\xf9\x2f\x7c\x7c\x5a\xc9\xdf\x14\x63\xc4\x0f\x4b\xe0\xd1\x38\x42\x9d\xc5\x80\xec\x46\xe3\x24\x5a\x8a\x5e\x52\xee\x60\x27\xa4\x8c\x23\xc8\x48\x45\xc6\xca\xcc\xf8\x4e\x64\x2c\x69\x5c\x46\xc6\x9f\xc7\xdc\x1b\x7d\x5a\xcf\xf8\x7e\xcc\x67\x82\x19\x4b\x33\xe3\x87\x31\xfd\x33\x14\xf9\x2b\x1c\x83\x8e\x27\x61\x4e\xf4\xe8\xfb\xef\x0f\x92\xde\xe0\x7b\xd4\x71\xa8\x25\x17\x94\x9c\xd5\x93\x33\x4a\x46\xcf\xc5\x5c\x9b\x89\xbb\x2c\x0e\x48\x68\xe3\x77\xf5\x86\x88\x58\x1f\x0e\x49\xd9\x90\x6c\x9b\xe9\x18\xf3\x08\x21\x9f\x63\x74\xa5\xeb\x1b\x11\xc6\xc3\x50\x5e\xc6\xdd\x21\xdc\x85\xf8\x17\x2b\x08\xc0\x23\x3c\xca\x4d\x55\xc8\xb3\xab\x34\x3b\x6e\xc9\xf6\x78\x8c\x3b\xb8\x17\xa3\x7c\xc0\x29\x1d\x74\x6c\xfa\xf8\xc8\xdb\x96\x69\x17\xf9\x8a\x92\x50\xcb\x8a\xf5\x22\x12\x3e\xbe\x87\x93\x54\x04\xa3\xa1\x1f\xc4\x46\x4a\x46\x29\x50\x26\x11\x13\xf8\x40\xd1\xf3\xf5\x37\x34\x15\xec\x25\xf6\xec\xf8\xf6\x24\x91\x9d\x2c\x5c\x93\x0e\x36\x28\xf9\xeb\x45\xf0\x03\x4f\x01\xbe\x6a\xc5\xf8\xb5\xe5\x74\x4e\x88\xc7\x65\x4f\x13\x26\xd7\xf9\x7c\x1b\xfa\xac\x0f\x25\xca\x19\xf2\x99\x7c\x00\xfa\x1b\x07\x50\x71\xbd\x57\x28\xff\x4b\x3e\x97\x7e\xee\x1e\x36\x80\x6d\xb7\x80\x6c\x0d\x94\x86\xf1\x56\x1a\xce\xe6\x79\xb2\xd0\xed\xd8\x13\x9f\x68\x83\x43\x3f\x5e\x0f\x4f\x86\x63\xf1\x3b\xfc\x2f\x7c\x5b\xd5\xe8\xe4\x0f\x41\xcd\x99\x54\x21\x0d\x5c\xdd\x87\x9c\x73\xff\xda\x03\x64\xa5\xa7\xf1\xf8\x18\x1b\x5f\x7e\xdf\x63\xa2\x1c\xcf\x1c\xfa\xf6\xc8\x2f\xdd\x23\xbf\xb4\x47\x7e\x29\x47\x7e\x69\x8c\xfc\x32\xfc\xd7\x06\x7e\xa9\x07\x4e\xb0\x13\xeb\x0f\x63\xd8\x94\x65\x8e\x5a\x45\x12\x8e\x9c\x2f\x8c\xd6\xb6\xc0\x9c\xfa\x6c\xb0\xda\x34\x6a\x5f\xbe\xa0\xf6\xa5\xa8\xbd\xd5\xb5\xd1\x2f\xb4\x03\xaf\xef\xed\x01\x80\x49\xa7\x61\xbe\x59\x5c\x87\xde\x70\xf7\x57\x9d\x48\x09\x17\x39\xcc\x3a\xd9\x1b\x8d\x55\xc2\xe6\xf1\x51\xbb\xe9\x1f\x6c\xac\xbc\xad\x95\xb7\xc5\x3c\xd1\x3d\x9c\x80\xc7\xc7\x3d\x20\x77\xe5\xf7\xd6\xc7\xdc\xbd\xe1\xd8\x44\xf7\x37\xa8\xbe\xc3\x85\x93\x4e\x89\x82\x32\x23\x56\x66\xc2\xa3\x80\x3d\x3e\x1e\xf9\xc1\xd1\x48\x11\xc8\xca\xc0\xb7\xf2\x5f\x0f\x86\xc3\xd1\xfe\x3e\x39\x84\x00\x0a\xab\xca\xdf\x6e\x56\x79\x06\xdb\x07\xdc\x98\x17\xa3\x4b\x57\x4c\xfc\x5c\xb0\x59\x52\xf2\x38\xc2\x7a\x2c\x28\x59\x5b\xc5\x73\xd7\x7d\x39\x1a\x1e\x57\x27\x80\x53\x7a\xd5\xb8\x0b\x7f\x0c\xf2\x13\xd5\xab\x66\x33\x56\x96\x79\x81\x0e\x44\xe3\x34\x7a\x20\x03\x95\x34\xbd\x64\x71\x31\x6e\xa3\x1f\x65\x01\x94\x20\xc1\xe7\x47\x20\x27\x97\xad\x85\x29\x57\x94\xa4\xd0\xde\x6d\x05\xcf\xc8\x0b\x0d\x95\xfb\x39\x5f\x17\x65\x6b\x41\xca\x95\x7d\x27\x19\x3e\xd4\xb4\xf7\xce\xf3\x45\xe9\x73\x06\x5c\xc6\xbc\xbd\xb4\xc8\x57\x6d\xa7\x69\x52\x3e\x53\xc5\x2c\xa4\x66\xb9\x7d\x62\x92\x18\x58\x39\xb8\x8d\xbf\xb1\xda\x62\x04\x86\x67\x02\x55\x47\xfa\xd4\xa9\x65\xef\x5a\xf6\xf0\xd7\x8b\x37\x2f\xdb\x41\x28\xf8\x87\x36\x11\xca\xbf\x78\x1f\xa1\xec\x8b\xb7\x12\xca\xfe\x91\xdd\xc4\x61\xfc\xa1\x0d\x85\x0a\xff\xc4\x9e\x52\x37\x7f\x68\x5b\x69\xce\xff\xdc\xce\xe2\x9f\x01\xd4\xaf\x95\x33\xf7\x78\x79\x5b\x36\x6d\x58\x0d\xc2\x32\xaa\x21\x01\x20\xa3\x12\x0f\x5d\x28\x8d\x81\x38\xf2\x34\x2f\x8e\xef\xce\x45\x2f\xe2\xc9\x31\xb7\x1c\x50\x28\x1d\xfd\x47\x3b\x3a\x91\xb8\xac\xde\x45\x12\xb1\x93\x36\x18\x1c\xb7\x23\x18\x18\x9a\x86\x69\x24\xec\x12\x0b\xa1\x50\xd0\x9d\x44\xa3\x8d\x02\x09\xe0\x44\x61\x07\xf4\xfb\xc7\x3f\x39\xd4\x14\x48\x0d\x27\xe6\x39\x2f\x90\x26\x4e\xcc\xb3\x5c\xa0\x49\x1d\xe0\xbd\x2c\x98\x47\xb1\x87\x3e\xf6\x82\x35\xfc\x28\xd1\xad\xc3\x77\x3f\x0c\x87\x07\x69\xef\x87\xe1\xc1\xb2\xb7\x0a\xb6\xd1\xa2\xd7\x3d\x24\x8b\x17\xf8\xbb\x96\xab\x86\x9e\x1e\xb6\xb0\x6a\x9d\x6e\xaf\xb9\x21\x1e\x05\x36\x83\xa5\x34\xee\x37\x92\x54\x4d\xdb\x6f\x04\x8c\x43\x3b\x1a\x1a\x32\x12\x4a\xa5\x5a\x5e\x75\x10\xfb\x87\xb1\x6e\xeb\x3a\xc9\xe2\x62\x7b\x0e\x6b\x33\x5b\x46\x4d\x90\x22\x62\x43\x3e\xea\x24\xc6\x75\x96\x28\x87\x97\x85\x91\x5a\xf0\xa8\x52\x43\xd2\x25\x56\x76\x67\x41\x72\x5c\x68\xe7\xd9\xb2\xb5\xd8\xa8\x47\x6f\xc2\xb1\x74\xae\xd9\xe2\x3a\xa5\x22\x2b\xd1\x4a\x69\xd3\xa3\xdb\x14\x45\xad\x7a\x49\xaf\xf0\x0f\x8f\x88\x66\x45\xef\x86\xa5\x8a\xd5\x93\xe2\x65\x57\x8e\x53\xb8\xa3\xc8\xf4\x1a\x5d\xd6\xf4\x47\x41\x4e\x71\xef\xd9\x24\xbb\x7a\x5d\xf9\x90\xef\x5a\x10\xb5\x0e\x25\xdd\x8d\xc7\xe9\xc9\xf0\x38\xe6\x2d\xf4\xcc\x16\x8e\x9f\x6b\x01\x8b\x17\xfe\xb8\x3f\xb2\xcf\x83\x43\x7b\x57\xb8\x90\x41\x3e\xc0\x43\xdb\x59\xa0\x75\x79\x43\x68\x3b\xfe\xf8\xd8\x1f\xed\x19\x49\x17\xcd\xa4\xbf\x77\x7d\xbe\x96\xb5\x73\x77\x91\x73\x8c\x83\x87\x22\x56\x64\x50\xac\xf5\xfd\xa9\x4b\xbb\xad\xbe\xb4\x89\x01\xb6\x1c\x15\x15\xd2\x78\xc6\x30\x35\x00\xf0\x0d\xba\x0b\xb2\xee\xdd\x43\xb3\x60\x5d\x1e\x55\xd6\x59\xbd\xac\x1f\xb6\x0f\x47\x44\xb7\xea\x18\x96\xec\x27\xd4\x5f\xb9\xbe\x2e\xab\xc2\x1b\x06\x7f\xf6\xe9\xb8\xa8\x94\x3f\x07\x47\xb5\x94\x1f\x20\x25\x78\xaa\x0b\x7f\xfc\xd4\x7a\xc8\xc0\xce\x7b\xb1\xb4\x8a\x88\x71\x0d\xeb\x76\x4b\xf8\x58\x49\xa6\x4b\xb8\x69\xf8\xe6\x82\xaf\xfd\xa4\x9e\xcb\x0d\x1f\xe2\x06\xae\x93\x7d\xb8\x80\xd9\x40\x52\x86\x75\x84\x6a\x81\xab\x91\x34\x1f\x64\x9d\x8a\xae\x46\xb8\x34\x4b\x39\x83\xf9\xcc\xa9\x7c\x81\x3a\x02\x11\xe9\x06\x84\x5a\xd1\xdb\xee\x19\x15\x58\x6a\xbd\xcb\xaf\x4e\xac\xa7\xd1\xd0\x55\x43\x26\xec\xa4\x22\x05\x08\x94\x68\x88\x94\x71\xa3\x5c\x85\xae\x70\xa5\x92\xb6\x4c\x1b\x64\x50\x16\xd5\xb8\x21\xb3\xae\x7a\xc6\x33\x51\x5a\xbe\xfb\x03\xcb\x90\xb4\x2c\x43\x82\xcb\x20\x9f\xaa\x27\xc9\xd5\x49\x85\x16\x94\x98\x30\xd6\x5c\x81\xa1\xf1\x07\xb9\x3e\x2f\xc3\xc8\xf8\x59\xbc\x9e\xc4\xf5\x9c\xe6\x44\xa9\x75\x4f\x29\xf5\x19\xba\x27\xc9\x95\x42\xa1\xf8\xc1\x37\x26\xe1\xfa\x2c\xcd\xcd\xa0\xbc\x80\xba\x03\x4c\x24\xfb\x73\x6d\xa0\x31\x70\x57\xec\x3d\xd1\x7d\x68\x6f\xe0\x1e\xc5\xe9\x30\xed\x86\x98\xd3\x8c\xa8\x1a\x24\x15\xbb\xd5\xde\x8e\x51\xbe\xa0\x8c\x24\x54\x19\x7e\x8e\x1f\x1f\xbf\x23\xbe\x47\xee\x2a\x67\x70\xcc\x81\x4a\x13\x2f\xc7\x01\x69\x2e\x98\xd5\xa7\x6b\x64\xe2\x18\xd5\xbb\x21\x7b\x2c\xb7\xab\x2e\xc3\x03\x9d\x69\xa1\x25\x1c\xd9\xb9\x20\x81\xd4\x6b\x4e\xac\xa6\x79\xda\xb8\x32\xcf\x13\x33\xba\xa7\x27\x22\xee\xf4\xbc\xf9\x4e\xd7\xf6\x92\xc4\xdd\x1c\xa2\xa2\x46\x74\xf8\xf1\xfc\xfd\x5b\x61\x04\x9d\xc5\x77\xc9\x22\xae\xf2\x82\xf4\xca\x4e\x17\x28\x72\x01\x64\x2e\x44\x42\x08\xdc\xb1\x1a\x02\x1c\x22\xc7\x29\x79\x37\xbd\xbb\x4d\xf9\x68\x3e\xc6\x19\xf0\xcf\xa4\xba\x17\x35\x93\x07\xf8\x4a\x27\xc7\x53\xa1\x9e\xc0\xce\xf1\x04\x40\x0a\x72\x5f\x50\xfc\x69\xbf\xf4\x15\x5b\x01\x6f\x62\x68\x73\x76\x07\x47\x46\x97\x26\xb7\x28\xf7\xec\xfa\x5b\x52\x61\x28\x32\x38\xea\xe7\xe8\x68\x5e\x17\x40\x51\xc6\x6d\xfe\xfb\x13\x99\x65\x7b\x5e\xde\x9e\x75\xdd\x92\x33\x32\xdf\x93\xb8\x9f\xbf\x13\x76\x18\x8f\x47\x5a\x58\x2e\xb9\xdd\x9d\x75\xd6\xb2\x79\x01\x35\x9a\x8f\xb7\x87\x22\xe7\x89\x9d\xd3\xed\xbc\xaf\x60\xe7\x20\xd3\xe1\x45\x9d\xe1\x49\x44\x61\x22\xbe\x6f\x5b\x8e\x06\x01\xa7\xfd\x82\xaf\x30\x7b\xc2\xdd\xe5\x8a\xb1\x6f\xdc\xd5\xb5\xa1\x08\x28\x9f\xd6\x63\x6e\xaf\x6b\xa8\x68\x24\xa8\xd4\x86\x9a\x83\x3c\xb6\x26\x52\x94\x4a\x9e\xa0\x3a\x62\x3d\xf9\xc4\x91\x41\x57\xef\xe7\x1b\x68\x50\xba\xe2\xc4\x14\xcf\x31\x09\xd3\xb9\x9f\xf5\x4a\x64\xa8\x14\x89\xb1\x4b\xe2\xa3\x16\x16\x5d\x4d\x47\xba\x35\x96\x9d\xf7\xd0\x9f\xce\x9e\x72\xd1\x8f\x83\x0e\x8d\x56\xf5\xb4\x0c\x5d\x00\x9e\xe0\x19\xab\x00\xb7\x99\x78\x8c\xa6\x25\x33\x72\x26\x0c\x11\xc2\xde\x50\xf8\x8a\x67\xbd\x9e\x65\xa7\xa8\xd7\x00\xd5\x2d\x3d\x7b\x17\x46\xb5\x5d\xb0\x4e\x0c\x3f\xde\x4f\x6c\xb3\x49\x16\xd4\xd6\x53\x15\x32\x5f\x8e\x48\x3d\x1a\x03\x8c\xff\x54\x58\x8f\xf4\xaa\x25\x71\xf0\x8c\x92\x3c\x14\x39\x16\x7f\x7c\x14\xb9\xfc\x04\x7e\x79\xb2\x0c\x9c\xc2\xa7\x0b\xe4\xcf\xd4\x2f\x5b\xf2\x4d\x84\x21\xca\x96\x1c\x85\xe7\x6b\x7c\x5e\x1b\xb1\xef\x0e\x7f\x18\x02\x9f\xea\xf9\xc6\xc4\xed\x30\x93\x8e\x95\x24\x89\x3a\x46\xbe\x04\x2e\xc2\x93\x64\x96\x41\x64\x91\x4c\x1e\x48\xf7\x60\x54\x8f\x69\x98\x08\x27\xc7\x25\xc2\xb8\x7e\xd9\xed\xa4\xb0\xaa\xd9\x31\x9e\xc3\xb6\xf5\xe7\xba\x0a\x7c\x12\x41\xc3\xd4\xc0\x35\x0a\xe0\x2b\x59\x3f\x0f\x81\x5b\xb5\x1f\x1f\x96\x87\x31\x1f\xc4\x2a\xca\xfa\x05\x70\x96\x59\x6f\x75\x5c\x86\x0b\xb8\xfd\x8e\xa3\xf2\xc4\x23\xb7\x36\x09\xaa\xf7\x92\x07\xbf\x15\x30\x44\x38\x97\x94\x07\xe5\x87\xe3\x18\xf2\x23\xf4\xa0\x9f\xb2\xdf\x00\xb5\xf2\x4d\x86\x31\x01\x64\xa1\x73\xce\xae\xd3\xf6\x4c\x19\xa4\xea\x89\xcc\x3b\x56\x98\xb9\x52\xc9\x90\x3c\x86\xab\x14\x8c\xd2\x58\x4f\x20\x75\x37\x4c\x98\x61\xf7\xd2\x8f\x2e\x26\x98\x7e\x75\xe5\xb7\x3b\xfc\x9e\xcc\x15\xe1\x37\xcd\xaf\x7a\x11\x52\xd9\x94\x1f\x97\xf2\x03\x63\xe6\x51\xf4\x4b\xfc\x58\x40\xa2\x35\x54\x99\xa0\x86\xea\x8c\xe0\xc5\x33\x6a\x21\xc1\xec\x44\xe5\xfb\x1c\x93\x13\xd4\x21\xe1\x9e\x70\x3e\x02\x59\x94\x52\xda\x93\x9a\xeb\x58\x82\x6b\xf0\x9c\x25\x77\xd6\x07\x3d\xfb\x94\x56\x92\x1a\x2c\x4f\xf9\xdb\xc7\x1f\xcd\x8f\x77\x47\xfa\xeb\x1c\xf5\xce\x89\xfc\xca\xcc\x36\xce\x97\xf9\x3d\x7a\x5d\xe4\x6e\xfc\x29\x9d\xc1\x5d\x45\xeb\xb5\x8a\xb3\xb7\xf3\x05\x93\x01\x7a\x28\xc9\xd6\xc2\xa5\x24\xda\x80\xfa\xb6\x2a\x77\xf1\xf8\x51\xa0\x9a\x3d\x8f\xc7\x95\x17\xe8\x9f\x1a\xfd\xe5\xab\x85\x6f\xe4\x9e\x53\xac\x43\x95\x5f\xc2\x10\xb9\xda\xd3\xa7\x4c\x41\x86\xcc\x40\x25\x45\x0e\x8d\x46\xe0\x44\xfc\xac\x3b\xd1\xe6\x69\xce\xad\x43\xeb\x00\xe3\x7b\x17\xea\xdb\xbe\xc5\x1e\xc0\x15\xf7\x02\x55\xe5\xb9\xdf\x4c\x15\x06\x05\x68\x0c\xed\x0a\x00\xe3\x4f\x24\x13\x36\x29\xae\xae\x28\xe8\x03\x69\xd8\x38\xe3\xf9\x73\x1e\x06\xdb\x72\x71\x73\x7b\x44\x78\x8a\x17\x8e\xa1\xa0\x62\xf6\x46\x5a\x4f\x3f\x27\x75\x35\xac\x1f\xd7\xeb\xe7\x3e\x26\x3b\x63\xcf\x52\x98\xf0\x64\x92\x5f\x41\x0f\xb1\xb0\xf4\xa3\x9b\x36\xf3\x62\x32\xf7\x94\x3d\xee\xb4\xb5\x10\x15\xe2\x35\xbb\xa8\xf1\x8e\x3f\x65\x78\x43\xac\xa4\x1d\x1f\x92\x12\xa3\x4f\xd1\x0e\x6b\x43\xa2\x28\x2e\x1e\x06\xbc\x77\x74\x01\x73\xcd\x5d\x73\x55\xd7\x83\x88\xb0\x19\x3d\x88\x10\x9b\xad\x42\xd2\x78\x70\xcd\x60\x0f\x3f\xc7\x28\x72\x0e\x62\x23\x7e\x66\x06\x5f\x71\x31\xc3\x00\xdd\x41\x4e\x51\x57\x09\x3b\x7f\x7e\x8f\xfa\x15\xa2\xa4\x67\x0a\x4f\xdf\xd3\x0d\x70\x11\x17\xab\x86\xad\x1a\x50\x60\xab\xd2\xd2\x3c\xd0\x85\x2d\xd7\xc2\x77\xa6\x14\x48\x6f\xbd\xc1\x1f\xb8\xf5\xc6\x92\x9b\x82\x7c\xdc\x07\x22\xf4\xb5\x19\xea\x5a\x6a\x9d\x22\x4d\xa3\x25\xb8\xe2\xc1\x1a\x40\x07\x85\xb8\x83\x0d\x49\x48\xb7\x70\x55\xc6\xe2\xc5\x96\x6b\x22\x97\xea\x9b\xab\x1c\x07\x69\x2b\xab\x32\x4f\xee\xba\x7e\x98\x0a\xad\xe8\x55\x5e\x26\x14\x65\xaf\x1b\x5f\x97\x79\xba\x46\x5b\x15\x99\x97\xb2\x9b\x2a\x2a\xb8\xbe\xb4\x4c\xab\x72\xb8\xe8\xec\x24\xae\x79\x9d\xdb\x89\x42\xdb\xba\xb4\x53\x7f\x27\x0d\xc4\xe8\xaf\x7f\xfd\x6b\x60\x3d\x62\x5a\x6a\x74\xa9\xa0\x60\x69\x37\x38\x6b\x97\xba\x37\xd0\x34\xb9\xca\x9e\xd8\x15\xa5\xd1\xc2\x9b\x34\xb4\x58\x74\xea\xa4\xba\x32\x1e\xa6\x85\xd6\x0a\x1f\x90\x55\x48\xe8\x30\x37\x41\xc5\x61\x61\xd7\xc2\xc5\xb6\x6e\xfd\x60\xb6\x8c\x8b\xd3\x4a\x18\xe6\x75\xff\x81\xc2\x16\xf4\x1d\x2e\x38\xc4\xde\xe8\xb5\xf6\x1e\x89\x96\xb5\xc4\xae\xcb\x3a\xbd\x91\x7f\x02\x55\x20\x79\x1c\xf3\xea\xd9\x93\xd5\x0b\x57\xf5\x0c\x92\xc7\xf1\xce\x20\xf3\x4d\xe6\x09\x57\xe6\x0d\xf7\xda\xc3\xe6\x3f\x6e\x9b\xe2\x2e\x29\xa2\xd1\xbc\xbf\x3c\xfd\x3a\x08\x23\xcc\x33\x8c\xf7\xf7\x63\x64\xd9\x42\x3f\xc6\xe8\x20\x75\x7d\x00\x28\x03\x99\xc6\x93\x7a\x7e\xef\x7e\xe8\xc5\x68\xe9\xa3\x43\x25\x44\xaf\x82\x3e\xf3\xc7\xc6\x27\x33\x68\xf0\x0a\x15\x71\x5c\x36\x52\x4f\x1f\x14\x26\x40\x57\xeb\x9c\xd2\xcd\x06\x9c\x99\xcc\x31\x54\xdc\xbb\x3c\xf0\x42\xf7\x09\xe8\x16\xf6\x6a\x71\x18\xb7\x69\x86\x9c\xb4\x69\x8c\x30\xae\x31\x52\x1f\xca\x98\xc9\xb8\xcf\xe7\xae\x91\xd6\xc6\x62\x02\x36\x93\xd6\x5c\x87\xff\xa3\x58\x5c\xff\xc3\xf3\xfe\x31\x7f\x18\x05\xdf\xed\xfc\xe0\x1f\xe5\x81\xfb\xe3\x1f\xfe\x7f\x3d\x1c\xb0\x0d\x9b\xa1\xb6\xab\xd0\xde\x28\xc6\x4a\xac\x9f\xa0\x53\x36\x94\xe9\x2f\xcc\xb4\x23\x9e\x76\x6d\xa6\x7d\x47\x69\x16\x6f\xce\xe5\x19\xe7\xd2\x25\x42\x73\xab\x48\xd9\x95\xa1\x40\xe9\x79\x39\x4c\x53\xaf\x91\x0b\x06\xd4\x7d\xaf\x39\x7c\xd8\x9e\xdf\x58\x81\x2f\xe8\x03\x72\x95\xe4\x91\x08\xa7\x03\x73\xfe\xc7\xe0\x1f\x73\xff\x10\x51\x2f\x4a\xce\x1d\x32\x01\xdb\x99\x8b\xf6\x91\x49\xa2\x1d\xa5\xc2\xbd\x97\xe0\xdd\x3c\xba\x7a\xfd\xc3\xe3\x63\x21\xe4\x5d\xea\xb2\x34\x14\x47\x94\x3b\xb8\x96\x67\x3d\xcb\x47\x08\x39\xa1\xe4\xf2\xef\x44\xbd\x0a\x24\x98\x6a\xba\xe1\xab\x7c\x8b\x67\x17\xb9\x59\x9c\x61\x91\xa4\x5e\x66\x78\x38\x14\xb6\xb9\x86\xc2\x2d\x17\xa0\xbf\x22\x09\xfa\x2b\x2e\x42\x17\x22\xc4\x86\x0c\x06\xce\xb5\x15\x61\x18\x1f\x17\x6d\x27\x90\x1e\x4a\x47\x7c\xe9\x09\x32\x26\xcf\x6c\xdc\x5f\x1b\x19\xb9\x5b\x32\xfc\x42\x38\x98\x42\x86\xf2\x39\xb5\xdd\x77\xbf\x25\xbf\x53\x2c\x12\x47\x18\x18\x81\xb1\xe0\xcc\xea\xc5\x56\xe5\x6b\x81\x9b\x6a\x78\xc6\x6e\x00\xf6\x17\xfe\xfe\x7c\xf1\xf1\x43\xd4\xed\x06\x4e\x13\x45\x69\x54\x3b\xa5\x6e\x84\xc5\xbd\x4e\x80\x5b\x02\x09\xdd\xad\xa7\x23\x01\x89\x1c\x33\xe4\xa2\x67\x77\x1b\xd8\x88\xcc\x35\xf4\x96\xe8\xde\xda\xaf\x71\xa8\x51\x3f\x3e\xd7\xa0\xea\x7a\x91\xdf\x37\x46\x61\x35\xe4\x3d\xd7\x6b\x5b\x98\x5e\x7e\xeb\x4e\xae\xa4\x38\xc8\x68\xdf\xac\xc2\x79\x7b\xc0\xe1\x12\xfc\x2a\xf3\x92\xa8\x55\xb4\xe3\xf0\x8a\xc0\x26\xb1\x0e\x0a\x98\xf8\x95\x50\xe2\x85\x79\x8d\x59\xc0\xbd\x31\x8c\x93\xde\x68\x67\x48\xdd\x9f\x02\x25\x3e\xea\xd1\x70\xa8\x80\xea\xbd\xe6\xfe\xd0\xb1\x8d\x23\x19\x10\xc6\xf6\x9a\x5d\x20\x12\xe2\x2c\xe6\xea\xb4\x24\x66\xaa\x71\x82\x63\x52\x92\x7a\x9b\xcd\xbf\x46\x0d\x9d\x2b\x34\xa2\x45\x5a\x55\x94\xb8\x8c\x1a\x7a\x55\x54\x42\x20\x6b\xa5\x20\xa4\x9b\xec\xf3\x9f\xe7\x28\x42\xfc\xaa\xb4\x26\xad\x32\x97\x66\x99\x4b\x3f\x3c\x3a\x4e\xf6\xf7\x8f\x8e\x0b\xf3\xc4\x8a\xf0\xcd\xfb\xfb\xfc\x9d\x50\x7e\xb9\xe6\x5d\x35\xa7\x0c\xf7\x93\x98\x07\x6a\xf3\xe6\x19\x8f\x93\x9f\xa8\x6f\x4e\x99\x46\x85\x7b\x21\x49\xfc\xf9\x39\xce\xda\x54\x65\xc3\x18\xf9\xb9\x38\xcb\x80\x93\xd3\x0c\x18\xe3\xb1\x7d\xc9\x10\x9c\x83\x13\xd3\xe6\x5f\x8e\x88\x1a\x18\xda\xf7\x04\x16\x45\x98\xb4\xa0\xf6\xde\x6d\x5e\x92\x32\x41\x64\x69\xf0\x7b\x19\x79\xe9\x8d\x09\x04\xb9\xff\xfc\x7a\xfe\xe8\xca\xef\x3b\xea\xa0\x69\x97\xb3\x7d\xcc\xb5\x1a\xcc\xc8\x6d\xbb\xe8\x67\xf3\x2b\x54\x41\xd6\x9c\x78\xd6\xc8\x28\x77\x08\x73\xaa\x05\x1c\xc6\x47\x7a\xe6\x88\xa7\x51\x63\xf9\xa5\x0f\x24\xf4\x05\xcd\xed\xc4\x0e\x5e\x54\x2b\x28\xe5\xc2\xea\xa0\xc9\xf8\x4a\x0f\xb7\xdd\x59\x7e\xfb\xf5\x4d\x9e\x17\x73\xe0\xf9\x60\xe0\xfd\x1c\x05\x63\xb5\x74\x58\x98\x5e\x1e\xac\x78\x7a\x5c\xc5\x22\x83\xa2\x99\xd4\xd2\x96\x68\x7c\x43\x51\xdf\x18\x46\x4b\x03\x56\x70\xb2\x0a\x16\x57\x22\x66\x27\x60\x10\x8c\x37\x29\xec\xd9\x5e\x36\x76\xe5\x6f\x34\x79\xcd\x9a\x31\x8f\x1e\x50\xd1\x41\x86\xf3\x11\x6e\x8b\x0a\x2b\x2e\x42\xb0\x95\xf3\xb9\xe4\x43\x9c\xe1\xb6\x25\x7e\x6f\x1d\x4c\x1b\x39\x23\xcc\xe9\xaf\x83\x3b\x35\x2f\x91\xb5\x85\xf4\xe0\xa6\x9e\x3a\x85\xd4\x70\x4e\x3e\x6e\xef\x30\xdc\x85\x9a\x3a\x17\xdf\x44\x73\x1e\x4f\x08\x00\xfd\xe8\x0c\x8f\x02\xba\xa3\xe7\xe6\xf4\x2a\x94\xd3\x8b\xe6\x84\xfe\x7e\x82\x5b\x48\xdd\xea\xc3\x91\x60\xec\x46\xf6\x82\xa0\x33\x30\xc4\xb3\x13\x6f\x23\x41\xf8\x22\x5f\x71\xd5\x76\x1b\xd4\x6f\x71\x97\x83\x0d\xa1\x14\x23\xc6\x84\xa3\x50\xbf\x9e\xc6\xcf\x48\xb3\x03\x2c\xdd\x6c\x11\x53\xfb\x54\x09\xf2\xd6\xd6\x19\xa9\x97\x6d\x1e\x14\xd2\x66\xe1\x4b\x28\x9d\x0c\x05\x5e\x61\x06\x0b\x01\x82\xcc\xf4\x25\x81\xaa\x18\x7a\xf9\x2d\xfa\xd4\xc2\xfa\x40\x42\xbb\x70\xd5\x7f\x1e\x92\x77\xa2\x90\x7e\x2b\xca\x3f\xa8\x23\x90\xda\xb1\x22\x35\x1f\xa5\x75\x8a\x38\xd9\xcc\xa5\x2d\x11\x84\x5f\xd2\x33\x50\x0f\xf9\x87\xa9\x35\x54\x1c\xd7\x2a\x53\xe8\xc3\xa4\x1f\x15\xfd\x46\x46\x50\x6b\x0f\xb1\x96\xe9\x72\xe4\x05\xc8\x7a\xa2\xd8\xb9\x86\xd1\x15\x9e\xb1\x27\x72\x0b\xff\x6a\x3c\x81\x9b\x03\x91\xae\xd8\x52\x25\xdc\xcb\xa2\x96\x8b\x31\xe0\x7e\xd4\x6a\xc7\x4c\x39\x30\x2b\xd5\x51\xa3\x58\xad\x1c\xb4\xf0\x37\xd0\x6f\x07\xa9\x0d\x9f\x68\x8c\x61\x1f\xf0\x93\xda\x37\x54\x24\xe5\x63\xc0\x8b\x69\xfd\x38\xf4\x96\x21\x06\x0e\x5a\xe8\x47\xbe\x45\xb0\xc2\xf3\x24\x03\x1a\x2f\xfa\x69\x0d\xfe\xb1\xc2\xfc\xf5\x8a\xfb\xbc\x5a\xf4\xa3\x79\x1f\x7f\x07\xae\xa2\x80\xe2\xed\x60\x66\x2f\x40\x0c\xf9\xd3\x9b\x31\x7f\x72\x33\x16\xb8\x19\x73\x40\xed\x3b\x33\x40\x2a\xfa\x32\x71\x9f\x30\xe0\x97\xf1\x80\xfd\x11\x92\xab\x9d\xac\x40\xb9\xbc\xe3\xac\x72\x41\x3e\xa7\x27\x00\x46\x50\x8e\xfc\x11\x0e\xf6\x3c\x32\x45\x94\xf5\x63\xdf\xd2\x96\x51\x79\x18\xfc\x6f\xa3\xf5\xf8\x69\xef\xeb\xf6\x1d\xb4\x1e\x87\x63\x5d\xe6\x12\xaf\x58\xfc\x7d\x96\x14\x82\x54\x2f\x0e\x81\xfa\x53\x5a\x11\xbf\xbd\xfd\x72\xf1\x1e\x36\x49\xa9\xd2\xfc\xfc\xe9\xcb\xfb\xbf\x7f\xfa\xe5\xe2\xf4\x43\xc0\x37\x0b\xe7\x8c\xa1\x1a\xa7\x5e\xad\xa5\xc0\x1c\x96\x31\xed\xc0\x3a\x52\x7a\x28\xf0\x13\xcd\xab\xce\x6a\x6d\x60\x9a\xa8\x26\x7e\x5e\xfa\x46\x72\x64\x35\x2c\x4b\x44\x4f\x37\x1b\xd5\x86\xea\xde\xd1\xea\x05\x94\xbb\x61\x01\xe4\x0a\xd7\x60\x3d\x89\xa1\x53\x0f\x77\xb1\xe6\x3b\x0b\x7a\x09\x95\x11\xaf\xfb\x23\xa0\xb9\xb8\x27\xd7\xc1\xc7\xd3\xaf\xd3\xdf\x4e\x3f\xfc\xfa\x96\x22\xc9\xa5\xaf\x51\xc1\x2f\x15\x91\x8d\x25\x42\x32\x43\x10\x19\xd9\x3c\xba\x9c\x3a\x86\x4b\x11\x7e\x73\x63\x40\x0c\x6a\xe3\x35\x0a\x6c\x0d\x70\x81\x02\xa1\x90\x2b\xac\xf0\x3e\xa4\x88\x20\x8f\x8f\xe5\xf1\x8a\x47\x69\x5e\xc1\x70\x53\xee\x8f\x72\xc1\xa7\xfa\x92\x30\xc2\xbd\x23\x8a\x8c\x71\xb0\x38\x8e\x4a\xd2\xc8\xb4\x86\x9d\xc3\xed\x9e\x49\x7f\x9d\x0f\xdc\xb8\x0e\xc5\x0f\xea\x29\x6a\x9c\x05\x62\x2a\x63\x63\x2a\x62\xf0\x3a\xe9\x12\x03\x94\x57\x0d\x67\x4c\x7a\xed\xbb\x80\xa6\x70\x20\x6b\xc1\x72\x86\x05\x5a\x30\x73\xcb\x75\xd8\xed\x8c\xcf\xab\x31\x06\x74\x8e\x38\xae\x04\x43\x14\xac\xaa\x72\x6c\x0e\xff\x85\x43\x0b\x2b\x47\x44\x5a\x31\xa6\xc7\x47\xe0\xc6\x12\x3d\x10\xd5\x97\xd9\x4f\x3b\xaa\x74\x22\x25\xc6\x63\xac\xea\xc3\xeb\x07\x16\xce\x1b\xfd\x21\x86\x56\xb4\xaa\x3c\x94\xc1\xbe\xf3\x30\xb5\x9c\x46\x30\x58\xbe\x63\xe0\xa1\xf7\xf7\xeb\x38\x27\x6a\x62\x17\x69\x4d\xa7\x6e\xb8\x16\x6c\xa2\xec\xfd\x90\x76\x69\x2b\x13\x16\xba\x4c\x11\x24\x68\xb2\x96\xe9\x96\x33\x4c\xe9\x25\x83\x7b\x48\x45\x9e\x17\xf0\x5a\x8e\x0b\x01\xf8\x18\xdd\x4d\x41\x43\x7c\xc3\x51\x35\xe4\xec\x3a\xa5\x7d\x89\x8c\x97\x2c\x9b\x87\x7d\x66\x86\x12\xa1\x36\x02\x8f\xb6\x60\x45\xe4\xb7\x5c\xf3\xb3\xca\x84\xb9\x2e\x93\xc3\x6c\xb6\xba\x16\xb4\x5c\x62\x0a\xcc\x6f\x09\xa9\xc7\xb9\x9e\x1f\xdc\x26\x79\x50\xb6\xcf\xcf\x5c\x4d\x8e\x8b\xcc\xfe\x23\xfb\x01\xa0\x71\xaf\x5e\xe4\xeb\x86\x16\x3a\x5a\x87\x3b\x4c\x68\x2b\x2c\xaa\xbc\x0f\x1c\x8f\x88\xd0\xe6\x8d\x24\xb7\xe8\x2c\xea\x2c\x5f\xc3\x61\xbb\x88\x57\x1f\x4b\xe1\x09\x48\xe3\xdd\x09\x12\x92\xc3\xb0\x78\x5d\x6f\x87\x5e\x65\x1f\xa4\xff\x37\x91\x37\x29\xae\xc2\x44\xc8\x82\xc8\x5e\x6d\x9c\x71\xbb\xb5\x80\xec\xcf\xc4\xd7\x65\x80\x2e\xda\xbe\x8e\x6b\x0c\xa9\x28\xea\x53\xee\xe5\xb8\xc6\xc0\x89\xaa\xa8\xba\x41\x40\xa1\x68\x36\xea\x38\x4a\x50\x47\x45\x45\xab\x54\xd9\x9f\x93\x6c\xb6\x7c\x43\xee\x28\xa2\x84\x0b\x01\x68\xa8\x0a\x74\xca\xe8\x61\x83\x68\x66\x4b\xcf\xd7\x12\xe6\x64\x43\xc7\xd1\x11\x51\x2b\xcd\xc6\xc4\xf4\x06\xdf\x1f\x78\xd8\x2e\x1f\x7a\x0f\x25\xcd\x72\x16\x7c\xc6\x56\x81\x4b\x5d\xe0\xd2\x17\x8b\xa0\x0a\xd0\x27\x2f\x40\x3f\xe5\x3a\x58\x05\x2e\x75\x81\x4b\xa1\xc0\x92\x47\xa3\xbf\x0c\x0f\xc5\xcb\x2b\x7f\x81\x85\xdc\xec\xc8\x73\x8d\x9b\xf7\xdd\xd7\x23\x0a\xf4\xe8\xfb\xad\x15\xbe\xaa\x23\x80\xb4\x4e\xee\x07\xf9\xf1\x5f\xd1\x3c\x20\x8f\xfe\x3a\xec\xe7\xbe\x7b\x51\x7f\xf8\xf7\xc1\xf7\xc7\x39\x2c\x6c\x7e\x7c\x74\x34\xf8\x7e\xb7\x53\xcd\x73\xee\x12\x2d\x4f\x2d\x11\x12\x14\xb5\xd8\xe6\xa7\x38\x40\x37\xf8\x3f\x0d\xd6\xa1\x10\x67\x49\x17\xc9\x22\x40\x73\x1d\xae\x13\x17\x5c\x03\x77\x5f\x3c\x0f\xd7\x3b\x5f\xfa\xe4\x8e\xea\x00\x4a\x1c\x4b\x73\x79\x61\x5d\x47\xe8\x53\x51\xa8\xf3\x17\x18\xfe\xd2\x00\xad\x42\x83\x56\xe1\x04\xad\x42\x83\x56\xa1\x41\x8b\x03\xc6\x52\x02\x69\x2e\xf6\x37\xb5\xc6\x9c\x0b\x58\x48\xc5\xd8\x89\x67\x32\x77\x68\xb0\x41\xfe\xbf\x91\x06\x87\x68\x51\x2f\x89\xb1\xba\xfa\x8d\x34\x72\xf9\x4d\xb0\x1c\x09\xf3\xd5\xc3\x86\x38\xed\x60\x15\xf0\x32\x97\xa2\xcc\x65\xa3\xcc\xf2\x60\xc1\xf9\xae\x60\x8d\x17\x9e\xb1\x5e\xfe\x3c\x1a\x01\xd5\x31\x52\xe7\xb6\x30\xcf\x2d\x17\x31\x95\x6a\xdd\xe4\xfc\xc3\x79\xe4\xe9\xd5\xec\x8b\xd5\xf1\x0f\x67\x21\x8f\x4b\xa6\x6a\xa8\xb5\x09\xd7\x46\x8d\x4b\x51\xe3\xd2\x3f\xdc\xee\xe6\xfa\x1e\xf8\x4b\xa0\x2e\x89\xc1\xe8\xe8\x7b\xa0\x2c\xd0\xba\xa9\x35\x7b\x2d\xb8\xc9\x29\xbe\x10\x10\x46\xab\x9d\x21\x6e\x02\x6e\xf1\xec\x93\x94\x2f\x67\x5f\x2c\x6b\xcf\x73\xec\x4e\x5f\x14\xf2\x0f\xe7\xc1\xf3\xe5\x47\x66\xf9\xab\x60\x4a\xee\x9a\x9b\x63\xd9\xfa\xf2\xc4\x8c\x44\x74\x6e\xe1\x54\x5c\x8b\xbf\x48\xe6\xf6\x22\x19\xd7\x0d\xba\x91\xb6\x78\x60\x31\xb1\x4b\x31\xd0\xcb\xc6\x40\xb7\x7a\x62\xb0\xee\xeb\xe0\xf9\xf2\x23\xb3\xbc\x98\x18\x59\xf2\xd7\x78\xe0\x60\x4a\x7e\x6b\xd5\xdd\xc8\x5c\x1c\x84\xa9\xe7\xa4\x23\x2c\xd5\x24\xe0\x2f\xa8\xc9\xa9\x4c\x16\xd0\x29\xc2\xc5\x0f\x4c\x8c\x87\x51\xf6\x76\xad\x84\xa6\x1b\xe5\xe1\x2b\x0e\xda\x28\xd7\xb0\x98\xff\x34\xe1\x20\x29\x4a\x79\x6e\x46\x42\x1d\x01\x06\x31\xbf\xb0\x1b\x12\xef\x9f\x4e\xc5\xcc\xa2\x51\x0b\xe6\x15\xb6\x62\x61\x20\xb3\xfb\xad\x99\xaf\xbf\x1f\x72\xc2\x4e\x26\x7d\xdd\xdf\x37\xd9\x6b\x95\xdc\x2f\xd0\xac\x9e\xb1\xec\xab\x0f\x75\xac\x2a\x97\xee\x2a\x97\xaa\xca\x25\x56\x39\x21\x27\x8e\x8c\x44\x13\x1e\x3d\x19\xb4\xdd\x1a\x28\xac\xd3\x1d\x47\xaa\x63\x33\xf9\x32\xd2\x8d\xef\x76\xc2\x83\x40\x7d\x97\xa4\x1e\xc8\x71\x75\xc2\xfa\xd5\xb8\x3a\x8e\x4f\xaa\x7e\x3c\x1e\xee\x82\xd8\x72\xe7\x5a\x37\x22\x55\x2a\x48\x82\x59\x9c\xe2\x9a\x3f\xa0\x72\xd0\x18\x15\x92\x0a\xd2\xe7\x43\xf2\x5a\x15\xb0\x54\x93\xaa\x7c\x35\x46\x95\xa5\x6b\x40\xa7\xf9\x2d\xfe\x6c\x14\xe4\x54\xf5\x0e\x08\x76\xb8\x8f\xeb\xae\x06\xe0\x46\xae\xfb\x0f\xd8\x61\x74\x4b\x20\xc6\xa0\x73\x52\x52\x82\x7f\x0a\xee\x68\xb1\xa4\xf4\x2d\x24\x40\xb7\xf0\x97\x77\x6a\x1b\x56\x72\x0a\xba\xf4\x77\xae\x77\xbe\x81\x70\x2d\x45\xda\x9e\xd1\xc3\x2d\xf2\x40\x80\x1c\xb2\xb1\xa1\x32\x28\xed\x2c\xf7\xd8\x00\xb0\x4c\x95\x67\x8f\x8f\x47\xf8\x5e\xc6\x3f\x60\xa1\x25\x1a\x00\xce\x97\x98\xa8\x33\x68\x40\xd4\x43\xd7\xb7\x69\xf5\x37\xb6\x7d\x7c\x64\x83\x72\x99\xdc\xe0\x6f\x25\x8a\x91\x8f\x60\xa2\xf0\xd8\x4a\x27\x50\xe1\x19\x0e\xf5\x43\xee\x03\x4c\x72\x77\x92\x78\x88\xb1\x02\x06\xa7\xc9\x54\x1f\x52\x88\x25\xfb\xd0\x3e\x9b\x05\x2b\x85\xef\xf6\x5a\x80\x45\x4c\x41\xa1\xc5\x55\xf4\x9d\x34\x58\x4b\xe5\x18\x4c\x3d\xd2\xe9\x77\x43\x21\x2d\x17\x1d\xee\xea\xd6\xa2\x7a\xd4\x27\x8d\xb1\xa8\x51\x0b\x26\x17\xe0\xd3\x5a\x98\x17\x72\xae\x54\xc7\x39\x36\x2e\x67\x14\x45\x5c\x9e\x9e\x3c\xa9\x6a\x12\x74\x09\x18\x30\x03\x1d\xa1\xbd\xa4\xec\x7a\x85\x52\x53\x5c\x3d\xf9\xea\xbf\x03\xce\xa4\xee\xba\xaa\xb5\x87\xe7\x8b\xf2\x0e\x00\x5d\xdf\x27\x69\x7a\xc6\x3b\x11\x4a\x2f\x1f\xb7\x25\x4b\x6f\x90\xab\x20\xa4\x4c\x10\x34\x6e\x73\x92\xf5\x24\x82\xde\xf1\x16\x70\x58\x2f\x6a\x40\x91\xc6\x76\x7d\xd4\x4a\x7e\x49\x75\x79\xcb\xa8\xda\x73\xc1\xaf\x8e\x1d\xd7\x8e\x8b\xaf\x35\x6d\x04\x3c\x37\xe3\xab\x3c\xad\x3f\xbc\x50\x74\xc3\x9a\x0e\xbe\x45\x63\x48\x4c\xa0\xa5\x8f\x3c\xd5\x95\x3a\xd5\x78\xc2\x0d\x1c\x6f\xfa\x68\xe0\xda\xae\xd3\xd3\x8b\x8b\x2f\xe7\x83\xba\x8e\x79\xf4\x1c\x5a\x32\x54\x0e\x29\xf1\xfd\x3f\xdd\x80\x14\x1d\x3d\x21\x56\x0a\xea\x78\x23\x7a\x4a\x32\x1e\x34\x30\x56\xf4\xa4\x54\x3e\xb0\xcf\x61\xd4\xfe\x14\x10\xd4\xb0\x49\xf4\xc4\xbb\x5c\x50\xc7\xa7\xd1\x53\x1a\x07\x4e\x5a\x27\xcb\x33\xf5\x79\xc7\x68\xd5\xa6\xce\xeb\x40\xbf\x26\x38\x70\xbe\x04\x61\x71\x58\xff\x10\xda\x72\xd3\x60\x08\x92\xef\x11\x8d\x35\x36\xfd\x9f\x1c\x5c\x63\xad\xec\x41\x3b\x4f\x7d\xfc\x14\x96\xaf\xcf\xf9\x45\x95\x15\x1a\xa6\xba\x2f\xd2\xf8\x42\xa7\xdf\xa4\xcc\xcf\xf5\x57\x02\x33\x9d\x15\xf5\x54\x11\xfb\x0a\xd5\x73\xe3\x82\x22\x60\x45\x2e\x5d\x73\x29\xb2\x73\x18\xb8\x2a\xee\x01\xd5\x5a\xc9\x35\x10\x52\x22\x61\xcd\xc5\x91\x19\x63\x4b\x34\x9c\x53\xc3\xbb\xc0\x55\xe4\xa9\x41\x60\x50\x2d\x74\x26\x11\x25\x5e\xd3\x51\xfa\x42\x44\x52\xe2\x4f\x9a\xfc\xd1\x32\x33\x9f\x2b\x17\x42\x52\x70\x37\xce\x26\xf9\xd5\xce\x57\x41\xd2\x12\x83\x11\x02\x2e\x62\x69\x5a\x33\xc5\x87\x2b\xf9\xbc\xd8\xe2\x1d\x41\x26\x7f\xfe\xf2\xf6\xa7\xb7\x5f\xbe\xa0\x4b\xca\x4f\xef\xa6\x17\xef\xdf\xfc\x8d\xbf\x59\x9c\x07\xe4\x4f\xc3\x59\x9b\xbd\xa0\x76\x7f\xc4\x63\x90\xff\x3f\xbc\x7d\x7d\x77\xda\xb8\xd2\xf8\xff\xcf\xa7\x48\x79\xce\xcd\xe2\x20\x08\x90\x34\x6d\x21\x86\x43\x93\x74\x9b\xbb\x4d\xdf\x92\x76\xdb\xe5\x70\x38\x0e\x18\xf0\x6f\x1d\x9b\x6b\x03\x81\x6d\xf2\xdd\x7f\x33\x7a\xb3\x64\xcb\xe0\xf4\xd9\xbd\x77\xcf\x4d\xb1\x2c\xc9\xd2\x68\x66\x34\x33\x1a\xcd\x8c\x41\x0a\x19\xdb\xa0\x06\xe2\xc3\x92\xe6\xbb\xdd\xd9\x38\x89\x60\xc1\x3d\x3b\x84\xcb\xe3\xb2\x3a\xee\xd8\xb3\xc3\xe3\xe4\x2c\x67\x63\x2f\xdb\x9b\x8e\x3d\x6e\x6f\xaa\x55\x91\xdf\x7b\x67\xff\xfd\xcd\x80\xac\x92\x20\xa0\xc3\xc3\x85\x75\x28\x9f\x5c\x78\x3a\x70\x40\xff\x04\x88\x0f\x1f\xdb\xdc\xff\x79\xd4\x1d\xd9\x3f\x30\x22\x3d\x3d\x7a\x6d\x0d\xd9\x0d\x9c\xe1\x08\xad\x89\xad\xd5\x63\x4b\x6a\x0a\xab\xea\xa8\xa6\xbc\xb3\x3a\xf6\x7c\x47\xd3\x09\xcb\xc4\x85\x9e\x88\x7c\xad\x27\xd6\xe3\xb4\x86\xa6\xd7\x28\xa6\x86\x2b\x1e\x5a\x70\xaa\x69\x51\x6b\x02\x4c\x17\x51\x2a\xb9\x65\x54\x62\x1e\x1f\x76\xbf\x41\x9a\xe4\x98\xbc\x24\x8d\x13\x72\xd4\x24\x27\xc7\xa4\xd1\x7c\x49\x9a\xcf\x4f\x06\xe4\xce\x6e\x9c\xa0\xd3\x06\xab\xf3\x9c\x34\xea\xa4\x59\x27\xcf\xeb\xf0\xa3\x4e\xdf\xf2\x50\x20\x3d\x72\x4f\x6e\xc9\x0d\xb9\x60\x60\x1a\xb9\x9e\x4f\x91\x8a\x9c\x25\xa6\x3b\x50\x3e\xac\xc3\x0b\xf2\x4e\x45\x3c\x09\xc6\x33\x05\xa4\x77\x96\x45\x3e\x26\xa7\x59\x77\xe4\x1d\x33\x0a\xb3\x0c\x51\xeb\xc4\xa1\xb1\x67\x7f\x3c\x58\xf7\xe3\x01\xb9\xd7\xf3\x32\xf6\xac\x83\x1e\xb9\x55\x86\xe2\xb2\xa2\x59\x32\x98\xdb\xea\xbd\x75\xd8\x23\x37\xb6\x73\x38\x23\xcf\xca\x37\x9d\x39\x46\x4d\x04\xfa\xa1\x5f\xba\xef\xdc\x62\xf7\x07\x36\xfa\x91\x20\x91\xcd\x3a\x36\x4b\x67\xe6\xdb\xf7\x95\x10\xba\x4a\xc8\xcc\x7f\x04\x90\x23\x0c\xae\x11\xba\xa6\x5c\x05\x4a\x9e\xb6\xa9\x4a\xaa\x32\xac\xca\x14\x28\x95\xad\x2a\x1e\xe0\xcb\x07\xfb\x9a\x3d\xac\x48\x9d\x8a\xa9\x52\x9d\x99\xea\x21\x2c\x38\xe7\x33\x45\x85\x61\xda\x80\xd4\xa3\xa0\xe2\x39\x6f\xf0\x6b\xe4\x00\xae\x3a\x11\x46\x38\x17\x77\x32\x45\xff\x41\xc7\xae\x77\x95\x4b\xe4\xe7\x3c\xfd\x22\x3b\x2b\x63\x52\xb5\x7a\x05\xe2\xfa\xe2\xec\xc3\xfb\xf3\x77\xdf\x6d\x85\x1b\xff\xfe\x61\x28\x8b\x93\x13\xa7\x37\x97\x5f\x2f\x92\xf2\x66\x52\xfd\xe2\x7d\x52\x7c\x94\x14\xbf\xbd\xfc\x7c\xf3\x3d\x79\x73\x2c\xdf\x5c\x5d\xbe\xff\x72\x73\x01\x45\xcf\xb5\x4f\xca\xe2\x13\xfd\x93\xb2\xfc\x85\xf6\x49\x59\xfc\x32\xfd\x49\xf9\xe6\x15\x49\x0e\xb0\xbe\x7c\xc6\xc9\xe8\x93\x14\xa5\xc9\x1c\xaf\x2f\xbf\xc9\xd2\xa6\x12\xc8\xf7\x12\x0b\x8e\xb4\xc6\xbc\x30\x99\xd6\xef\x17\x17\xbf\x61\x49\x32\xab\xab\x0f\xef\x6f\xde\x62\x51\x32\xa3\x4f\x5f\x7a\x9f\x6f\x2e\x68\xff\xc9\x74\x5e\x5f\xf6\xde\xbf\xff\xd2\x7b\x67\x37\x92\xb9\x88\x92\x57\x8a\x04\x7a\xd6\x3b\x87\xa2\x66\x32\x89\xb3\x8b\xf7\x00\x8a\xf7\x97\x58\x9a\x4c\xe2\xfd\x97\xab\xe1\xaf\x9f\x7b\xef\xbf\xbc\xeb\x7d\xbe\xbc\xb9\xbc\xb8\xb6\x9b\xea\x5c\x6e\x2e\xde\x5c\x5e\xbc\x3b\x1f\xaa\x4b\x9e\x94\x5e\x29\x2b\x9e\x94\x9e\xdb\xa6\x1e\xde\xbe\x55\xd6\x5b\xe9\xe2\x4a\x59\xec\xa4\xf8\xfa\x5a\x59\x70\xa5\xf6\xb5\xb2\xe0\x38\x74\xf9\xea\x5a\x5d\x71\x64\xe9\x1f\xdf\xf5\xce\x2e\xae\x60\xce\x78\xba\x64\x7e\xd3\x4f\xe3\xf5\xc0\xfe\x81\x74\x36\xf1\x5c\x7f\xdc\x32\x0d\x8a\xc4\x0b\x77\xde\x6a\x90\x78\xee\x8c\x40\xbe\x69\x35\xdc\xa3\xc7\x5d\x9d\xab\x14\x52\xf0\x03\x4d\xf9\x81\x66\x81\x0f\x68\xb4\x56\xf0\x0b\xcf\xe5\x17\x9e\x17\x99\x82\x42\xb5\x45\x61\x54\x57\x80\x74\xbc\xfb\x0b\x3a\x03\x28\xf8\x91\xa3\xe4\x23\x47\x05\x3e\x22\x68\x7d\x47\xef\x57\x57\xe9\x65\x3e\x29\x32\x03\x85\x2b\x15\xfc\x40\xb2\xcc\x8d\x66\x81\x2f\x68\x0c\xae\xe0\x27\x9e\x2b\x00\x7a\x5e\x68\x9d\x9f\x0a\xa4\xba\x02\xa5\xe7\x45\xd7\xf9\x89\x1f\x51\xd6\xb9\xf1\xb2\xc0\x57\x18\x57\xde\xd1\xf9\xdb\xb7\xe9\x65\x3e\x2a\x34\x03\xb9\x17\x14\xec\x3f\x59\xe5\x17\xcd\x02\xfd\x27\xbb\x4a\xc1\xfe\x4f\x12\x66\xd1\x28\x32\x01\xba\x17\xed\xe8\xfb\x3c\x0d\x9a\x97\x27\xc7\x05\x61\xf3\x84\xee\x15\xfc\x7f\xd1\x2c\xb2\xae\x6c\xd7\x2c\xd6\xf9\x8b\x04\x2f\xeb\xc7\x45\x3a\xe7\x1b\xf0\x2e\x94\x4c\x43\xa6\x79\xd2\x7c\xf5\x12\xc7\x5f\xdf\xf9\x05\xb9\x9f\x17\xfb\xc6\x91\xbe\xb0\x07\x47\x27\xcf\x6b\xcd\xe7\xcd\xdd\x8c\x42\x88\x08\xc5\x3e\x93\xe0\xcf\xf1\x51\xf3\x29\x9f\x29\xf4\x91\xef\x46\x4c\x2a\xfe\x11\x2e\xc8\x14\xfc\x8a\xb2\x1f\x34\x9e\x3f\x7f\xf1\xb2\xfe\x02\x23\x3e\xee\xfc\x48\x22\x1a\x15\xfd\x8e\xe9\x43\xea\x46\x9a\xab\x63\x9a\x2f\x30\xd3\xab\x54\xd5\xa3\x57\xed\xa3\x57\x1d\xdb\xa5\x77\x86\x93\x9b\x52\x7a\x0c\x49\xe2\xd9\x8d\x36\xd4\x52\x0e\x48\x23\xdb\x39\xf0\xda\xfc\x7a\x54\x24\x73\xfa\x2f\xd4\x40\x2d\x39\x2a\x81\x21\x5e\x4b\x12\x1f\xc1\x64\x97\x08\x40\xbd\x09\x4e\x73\xa5\xc7\x76\x20\x06\x15\xda\x8a\x3d\x44\x7c\x99\x6b\x16\xcc\xa7\xec\x30\xec\xd8\x91\xbc\xbd\xf8\x28\x02\x52\x6a\x86\x14\xd9\x70\x47\x20\xd4\xd4\xc2\x3a\x83\x1a\x5f\x1f\x43\xc8\xcd\xc6\x01\x53\x51\xd5\x00\x3e\x8a\xf2\xb3\x45\xbf\xca\xd1\xfb\x30\x84\xa9\xd0\xb7\xbf\xdc\x9c\xd1\xfb\x35\xe1\x4f\x05\x4b\xf5\xb7\x4c\x48\xe2\x25\xa8\xb7\x5b\xa6\x0d\xf8\x49\xe6\xbb\xe1\x82\x91\x6c\x94\xb8\xac\x78\x17\xa7\x3d\xee\x1b\x30\x7e\x60\xc7\x5a\xc4\xd6\x29\x54\x36\xd4\xbb\xe2\xf5\x58\x18\xd7\x9c\x4a\xe7\xbc\x12\xfd\x6a\x4e\x9d\xb7\x6f\x79\x25\x16\xf1\x35\xef\x73\xf2\x7b\x3c\x0e\x6c\x4e\xbd\xeb\x6b\x5e\x4f\x44\x87\xcd\xeb\xef\x5a\xf6\xa7\xc4\x01\x9e\x32\xa3\xc7\xd2\x1e\xf7\xfd\xc1\xbf\x66\x6d\x27\xf1\x01\x64\x5b\x11\xb5\x5b\xc5\x22\xce\xf3\xd4\xc2\xce\xfd\x41\xd5\x5e\x4a\xe7\xb6\x91\xed\x57\x1a\xed\xd1\xa9\x59\x69\x69\x8f\x80\x5c\xc6\xfd\xd1\xc0\x1e\xd9\x49\xdf\x0a\xc0\xba\x8d\x56\x9d\x7b\x8c\xf4\xd1\xbf\x00\x33\xd0\xb1\x78\xc3\x78\xab\xd9\xdf\xd0\x1b\xf9\xe8\x9a\xbf\xb2\x87\x7a\x48\x25\xe7\xd4\xd6\xc5\x21\xca\x51\x16\x9d\x15\x8c\x79\x55\xb1\xe7\xd0\x99\x44\x80\x95\x65\xb5\xdd\x8e\xbd\x6a\x5b\x1b\x69\xee\x58\xb1\x08\x34\xad\xa0\x3c\xe4\x44\xf0\x08\x5f\xc9\x34\x64\xa7\xfb\x49\xd7\x38\xff\x8a\x3d\x2b\x3c\x54\xf1\xe1\xb2\xd3\xb1\x35\x09\xe5\xe1\x41\xc1\x82\xa1\xf5\xaf\x19\x00\x08\xb3\xc6\xef\x18\xe1\x53\xbf\x2f\xf8\x43\x12\xce\x37\x31\x5a\x6b\xc7\x38\x39\xc5\xd4\x33\x65\xdb\x3b\xf4\xb0\xd9\xf2\x76\xb3\xfd\x6d\x13\x40\xba\xad\xef\x1a\xcb\x86\x69\x67\xcd\x44\x64\x5b\xb7\xe9\x66\xaa\xc9\x7a\x6b\xc3\xe6\xb6\x96\x96\xba\xe1\x7c\xf4\x97\x53\x0f\x9d\xf4\x1e\xd3\x45\xb5\x9e\x39\xc9\x9a\xe1\xb2\x6e\x3a\x80\x8b\x96\xa4\xae\x3f\x78\x4c\x92\x23\x24\x97\x96\x17\x21\x0b\xd5\x9c\x8d\xc1\x56\x52\xd3\xcb\xb1\xc1\x94\x52\xe9\xdf\xf0\x34\x48\x0b\xf4\x2a\x53\xf2\xd1\x03\x71\x7a\x41\x9a\x25\xe1\x4b\x9e\xc9\xd8\x1b\x9f\x63\x98\xac\xe4\xa5\x5a\x92\x4a\xd3\xc7\xd2\x00\x65\xf3\xc4\x19\xa2\x99\xa8\xb3\xcd\x4b\xcd\xa3\xd6\xc1\xac\x3c\xae\x12\x6d\x03\xfd\x8c\x72\x42\x9e\xb8\x86\xac\x7f\x98\xaf\x90\x3a\x04\x1b\x41\xad\xe7\x87\x97\x93\xcf\x2c\x91\x3a\xbf\x74\xc6\x49\x15\x2c\xa6\x58\xa8\x22\xc1\x18\x71\xe8\xed\x64\x76\x21\x9c\x0f\xc4\x1d\x0f\xd9\xd5\x70\xca\xdc\x58\xd2\x04\x71\x3d\x5c\x4d\xe2\xc2\x5d\x4f\x94\x89\xa3\x37\x8b\x08\x83\xd3\x52\xc2\xe0\x4c\x42\x1e\x02\xcb\x55\x33\x4a\x2a\x1b\x3c\x7b\x5d\xb2\x58\x8c\x1b\x16\xdb\x06\x65\x4c\x0c\x44\x33\xf1\xc3\xfb\x96\x08\x07\x82\x9e\x2d\xe9\x84\xc9\x1c\x33\x65\x71\x22\x44\x28\xa0\x6d\x07\xe9\x78\x4b\x0b\xab\x1b\xc0\x42\xa0\x43\x3b\x48\x1f\x91\xa5\x0d\x0e\xe3\x4b\xe9\xcf\xb2\xde\x23\xba\x5d\x48\x00\xea\xce\x8d\x84\x52\x21\xbb\x6c\xe2\x18\x6e\x98\x38\xc0\x33\x69\xb4\x89\xe4\x42\xc9\x69\x88\x89\x34\xe4\x63\x07\x1e\x2b\x61\xed\x3e\x29\xda\x40\x8d\x8d\xf2\x08\x35\x36\x50\x63\xc6\xfd\xd6\xe6\xf6\x4c\x99\x26\x08\x1b\x27\xed\x79\x7a\xaa\x34\x93\x2f\x73\x0d\x2a\x59\xf4\x2a\xdc\xbc\x96\x14\x89\x73\xaa\xed\xd1\x5b\xc4\xc2\x2f\xf7\x68\x42\xf1\x28\xfd\x8d\x25\x76\x3c\x66\x81\x5c\xfa\xcb\x81\x1d\xc1\x1f\xcb\x30\x14\x6f\x84\x77\x8b\x1f\x1e\xa0\xee\xc8\x77\xe2\x98\x66\x5a\x2c\xe5\xe6\xbb\xb3\x48\xb6\x8b\x51\x1c\x9f\x61\xd3\x12\xfb\xa4\xec\x86\x45\x92\x9f\xd7\xc4\x7b\x71\x78\x95\xed\xe1\x9e\x25\x03\xee\xce\xd9\x65\xee\x56\xe3\x84\x6c\x0c\xd5\x66\x1c\x64\x50\x8f\x67\x05\x6d\x9c\xe0\xf2\xe5\xcd\x4a\x9c\x7f\xe5\x41\xd2\xbb\xc3\xd0\xe1\xc3\x5a\x1c\xe1\xa0\xb0\x0d\x19\xf2\xa0\x4f\x23\xf8\xc5\x23\x3d\x6d\xc8\x58\x8b\x73\x33\xe4\x1e\x41\xea\x3a\x67\x06\x10\xcf\xc2\x68\x81\x79\xf9\x11\x28\x7a\xfb\xd4\x68\xb0\x12\x12\x6b\x59\xeb\x4f\xb6\xe7\x7e\xac\x2b\x3b\xb9\xf2\x34\x3a\x6c\xb6\xc7\x6a\x24\xab\x15\xa5\x53\x5a\x6f\xc2\x52\x3d\xcd\x79\xd6\xb9\xde\xe2\x35\xf3\x13\xe3\x3e\x95\x1c\x57\xab\x9b\xea\xb4\x1d\xf7\x57\x83\xee\xba\x6a\xe3\xbf\x2d\xfc\x63\x83\x6e\x07\xff\x54\xec\x69\x65\x43\x26\xf6\x9a\x4d\x73\x62\x27\x77\xa9\xb0\xd9\x58\x89\x97\x35\x61\x0c\x62\xac\xc5\xcb\x1a\xe9\x85\x02\x8a\xa2\x74\xe1\x2d\x7c\x57\x23\x92\x1a\x7a\xf5\xc8\xfa\x34\xef\xa5\xcd\xff\x8d\xaf\x9c\xf9\xb0\x0f\x9b\x2d\xa0\xd3\x40\x56\x61\x09\xa4\xce\xf2\x2b\xce\x81\xe1\xae\xec\x31\x71\x0d\x7e\x46\x44\xdc\x4d\x12\x37\xdc\x78\xe0\x48\x4c\x4e\x6d\x8c\x54\x59\x22\x2c\xcc\xad\x65\xe5\xf4\x47\xfd\x01\x90\x35\xd2\x3e\xef\x52\x01\x29\xb5\x7e\xaf\x32\x2f\x0b\xf5\xbd\x5c\x28\x5d\xcb\x40\x98\x86\x9e\x95\x77\x3b\x3a\x4e\xbc\x7c\x02\xea\xf2\x73\x96\x07\x8a\xf3\xf4\x3b\xd9\xb1\xa7\xa3\xb5\x61\x63\x65\x12\xeb\x98\xa1\xf0\x1d\x72\x69\xd8\x04\x41\x42\xe1\x9e\x5c\x88\xa9\x77\xb5\xd8\x59\xa1\xb7\x2b\xfc\xa0\x81\x12\x59\xf0\x3b\xe3\xb2\xde\x69\xb1\xf2\xf2\x70\x7c\x52\xd9\xb4\xef\xb8\xaf\x56\xc2\xd7\xc9\x1a\x3f\x81\xe1\x7a\xf4\xd2\xca\x94\x93\xb3\xa1\x89\x44\x7c\x63\xd3\x84\x2c\x9a\x55\xd0\x97\xee\x30\x08\x77\xec\xf2\xc1\x89\xd9\xd0\x9f\x91\x1b\x63\x94\x67\x3c\xb9\x4e\x8b\x44\xd4\xb1\x6d\xb7\x28\x61\x90\x2f\x6b\x3d\x0c\x9f\xf0\x34\x29\x72\xcd\x54\x75\x14\x6b\xd8\x62\x6d\x92\x82\x27\x8b\x94\xf0\xf9\x27\xcb\x92\x4c\xb4\x69\x29\x59\x95\xc9\x16\xf1\x92\xfa\xfe\xe9\xf2\xa5\x56\x94\x82\x26\xeb\x70\x9b\x78\x95\x17\x46\x45\xe6\xb8\xc7\x24\xf5\x96\x88\x5a\x67\xaa\xe9\x64\x93\xda\x5b\x95\xe6\x41\x5e\x55\xd4\x0e\xa8\x28\x45\x2b\xb6\x17\xd4\x6d\x2e\x5a\xb9\xd7\x73\x67\xe4\x62\x00\x04\xcc\x6c\xb5\x7b\x50\x6b\x31\x28\xaf\xed\xd9\x9a\xd0\x46\x5d\xe4\x85\x28\xd1\xcd\x7f\xd5\xda\x3a\x19\x29\xf1\xd1\x4f\x15\x9b\xcf\x1a\x93\xdb\x6b\xf3\x61\x64\x58\xf6\xe8\x8c\x9a\x18\x75\x07\x74\x24\x44\x13\x50\x76\x7f\x14\x80\x7c\xf3\xa9\xa0\x6f\x16\x86\x7d\x33\x03\xfc\xcf\x08\x17\x84\x3e\xa3\x7e\x65\xac\x9d\x26\xca\x9a\x3c\xd9\xc6\x87\xc0\xdf\xd0\xb4\xff\x9b\x2a\xea\x81\x7b\x20\x55\xee\xc5\x22\x8e\xdb\x9e\xb3\xa0\x71\xb1\xf6\x16\xa0\x51\xd7\xf6\xca\x37\xd1\x86\x66\xe6\x0e\xf7\x96\x34\x31\x87\xd2\x29\x26\x6b\xb4\x0a\xea\x43\x22\xda\xf3\xa2\x60\x70\xc5\xbe\x8b\xb7\x02\x54\xed\xc7\xc9\xd3\x7e\x70\xbe\x8b\xb2\xc6\x0b\x60\x15\xcb\x1a\x2f\xe0\x7c\x7c\x2b\xaf\xf8\x3b\x74\x22\x8d\x94\xf5\x80\x92\x02\x02\x6e\x3a\xcb\x30\x4f\x1d\x64\x55\x6a\xcf\x1f\x4d\x69\x40\xf4\x5a\x55\xa8\x25\x94\x23\x85\x03\x78\x3b\x89\xed\xe1\xc1\xdb\xc9\x25\x76\xd7\x91\xf8\x4c\x43\xbd\x32\xa7\xb7\xcc\x1e\x48\xe6\x46\xcd\x6d\x0a\xda\x12\x4f\x80\x3f\x86\x9f\x3c\x50\x10\x59\x1a\xfc\xf7\xb6\xab\x78\x5e\x31\xba\x5f\x64\x74\x3d\x96\x7f\x7c\x6b\x73\x2a\x81\x61\x5b\xc2\xc4\x76\xaf\x00\xd5\x8a\x0f\xe1\x76\xca\x98\x53\xab\x14\xa0\x45\xd9\x2f\x19\xd4\xcb\x47\x32\xc2\x9b\x13\xcb\x32\x65\x37\x1b\xfc\xb1\xc1\x1f\x4d\xfa\xab\x89\x24\xb5\xc9\x31\x90\x6f\x55\x9f\x40\x33\x1e\xf5\xb1\x03\x50\xa5\xbb\xf8\x6f\xcb\x4d\x82\x02\x07\x39\x2a\x55\x40\x73\x4a\x71\x95\x2a\x40\x95\x2a\x18\x58\xfc\x92\xe8\x76\x65\x8d\x23\x67\x68\x50\xb1\xaa\x08\xa0\x2a\x25\xad\xbd\x6c\x51\x15\x13\x94\x97\x61\x88\xe6\x77\x4e\xab\x84\x46\x78\x25\x7a\xdf\x22\x25\x95\x85\x20\xa6\x3d\xb6\x67\x5c\xc8\xe2\x57\xe2\x3c\x61\x62\x20\xab\x5c\xcd\x79\x62\xca\x15\xa4\x5e\xcb\x10\x71\x19\xb3\x6b\xee\xc2\x22\x3f\x3e\x16\xa0\x34\xba\xd7\x42\xb5\x61\x6d\x83\xea\x6f\xbc\xbf\x2f\x7e\x89\x1b\x63\x75\x21\xd4\x79\x09\x2b\x25\x77\x76\x7f\xc2\xf0\x60\xc2\x90\x80\x2d\x1d\x53\xf3\x53\x3d\x30\x6d\x1f\xe3\x3b\xdb\xe2\x0d\x86\x92\x48\xd2\xb6\xc8\xfc\x38\xb1\x08\x9c\x10\xd8\xab\xda\x9a\x42\xea\xdc\x6e\x90\x9e\x5d\xda\x34\x4a\xe4\xde\xc6\x48\x4d\xed\x86\x6d\xc7\x2c\x0a\x0d\xad\x55\x59\xd5\xee\xc9\x39\xc6\xb8\xc0\x6a\x4d\x56\xad\xc1\x91\xe2\xd6\xbe\x37\x1a\x55\xda\x21\x34\xdd\x54\xf0\x1e\xe4\xc1\xaa\x86\x49\x8b\x37\xe5\x18\x23\x89\xc2\x94\x08\x6c\x9b\xeb\x6e\xaf\xc5\xee\x89\x63\x2f\x37\x76\x58\xbd\x05\x85\xaf\xde\xb9\x81\xaf\xde\xa0\xe3\xe8\x4d\xe5\xb6\x72\xd4\x19\x77\x23\xa9\x0e\xe1\xbe\x8b\x49\x7b\x5b\x91\xa2\x9c\xdd\x30\x42\x43\xf7\x3b\x1c\x73\xb7\x1c\x69\x3a\x63\x6d\x5d\xbd\xcf\x90\xa7\x25\xca\xe4\xe6\xc9\xc9\x55\x76\x0c\x2c\xab\xe7\x7b\xd3\xc0\x2e\x45\x5c\xb2\x48\x60\x92\xfe\x00\x82\xa7\x52\xbc\x43\x6c\x86\xa4\xa9\x69\x93\x86\x31\xb2\x0e\xe6\x1a\x9e\x47\x96\xbe\x49\xc9\x23\x41\x84\xe1\x85\xad\xbd\xc3\xdb\x81\xb7\x76\x41\xc6\x48\xf1\xec\x2c\x49\xef\x76\x91\x40\x18\xc3\xbc\x56\x6e\xdb\x67\x9d\x71\x15\x9d\x29\x95\x37\xb9\xd5\x71\x29\xe9\xf0\xd9\xc0\xde\xa5\x88\x44\x50\x87\x1b\xf7\x16\x18\xfc\x5e\x6c\x20\x1f\x61\xb4\x8b\xf0\xa3\x1b\x8d\x30\x81\x2f\xbb\xb1\x5f\xb7\xda\xe5\x8f\x9d\xc6\xc3\x43\xbd\xf3\x51\xa4\x0b\xfb\x48\x53\xae\x7d\x44\x24\x79\x67\xbb\x65\x5c\x82\x8f\x80\x63\xf7\x5c\xc5\xe1\x65\x16\xf2\x03\x45\xd7\xca\x85\x84\xc8\x42\xc0\xa1\x30\xa3\x3a\x10\x0f\x7e\xb8\xa5\x8d\x22\x1a\x43\x1b\x55\x65\x9b\x09\x1d\xeb\x1d\x71\x60\x24\x1b\x4b\x74\x9a\x14\x01\xce\xcc\x68\xb1\xaa\x4d\xcd\x12\x6d\xaa\x69\xab\xbc\x00\x66\xfb\x13\x73\x69\xfe\xcc\x64\x9a\xf9\xb3\x71\xca\x1c\xd9\xad\xec\xb4\xd2\xef\x76\xcc\x8f\xca\xce\x5e\x01\x6d\x80\xb2\xcd\x35\x65\x68\x0c\x47\xee\xed\x09\xdd\x25\x35\x5e\xb8\xce\xf2\x42\x64\x84\x6b\xc9\x08\x19\x1b\x43\xfa\x45\x3c\x21\x8c\x35\x25\x5c\xa9\x31\x60\x82\xbe\x81\x5a\x47\xf4\x86\xbd\x42\xc8\x80\xf6\x61\x0e\xb5\xb7\x99\xf7\x70\x60\xe2\x38\xc0\xdb\xae\x2b\x86\xf2\xce\x14\x16\xf7\xda\x9e\x9a\xda\x6c\x61\x47\xa4\xde\xb9\xa6\x0d\xeb\x05\x58\x0c\x65\x54\xd7\x3a\x43\xfa\x29\xb6\xb3\x4e\xb3\x9d\xc7\xa7\xa3\xe5\xfa\x27\xb0\x72\x9d\x46\x4a\x0a\xe9\x0f\x3f\xc3\x56\x78\x20\x90\x3a\x51\x19\x0b\x63\x27\x0d\x8b\x7c\xb0\x19\xf6\x22\x3b\x99\x71\x76\x22\xca\xb0\xa4\x2d\x89\x81\xb1\x18\xf2\x41\xa1\x01\x37\xa1\x81\x0f\xf9\xa8\xff\x38\x53\x0d\x25\x66\x9b\x07\xd5\x15\xb2\x2a\x93\x66\xfa\xf8\x1f\xb3\xed\x83\xda\x1d\x87\x20\x8f\x0d\x95\xd4\x54\x6c\xdd\xd2\xa5\x1b\x73\x69\x33\x55\x8c\x71\x8f\x0a\x99\x4a\x94\x51\xff\x1d\x16\x93\x5d\xa7\x6e\x99\x5b\xd2\xc5\xe2\xc1\x8b\x5b\xd4\x5b\xf2\x27\xb8\x2a\xdd\x2c\x60\x4d\x29\x49\xb8\x0a\x07\x58\xd4\x36\x7a\x21\x23\xa7\x05\x08\x02\x5a\x31\xb7\x0a\x2f\x6a\x33\x5e\xae\x03\x53\xd5\x1b\x87\x39\x9e\x43\xa9\x55\xcd\x2c\x68\x66\x2d\xb3\xcb\x38\x20\xbb\xd4\x6a\x5d\x97\xde\xae\x59\xff\x63\x68\xc6\x06\x94\xef\x9d\xb3\x7d\x5d\x83\x2d\x0b\x1a\xa8\x0b\xda\xa0\xca\x50\x1d\x96\x03\x43\xc7\xb0\x75\x09\x94\xb5\x75\xc5\xda\x06\xda\xda\xba\x62\x6d\x03\x7d\x6d\x5d\xb1\xb6\xa2\x5c\xe8\xa4\x2c\xb6\x9a\x60\x4d\x1b\x4d\xfc\x6c\x26\xfb\xc5\x2e\xa5\x2a\xdc\x32\xaf\x30\x35\x42\x3e\x92\x30\x3d\xc2\x7b\xbd\x9c\xcd\x72\x76\xd8\xac\xc2\x2b\x2e\xa9\xc9\x97\x14\x46\xb4\xbc\x4a\xeb\xa4\x9a\x66\x76\x46\x9e\xc1\xab\x14\xd1\x43\xdc\x32\x06\x89\xa7\x00\xae\x96\x50\x6b\xab\x94\x5e\xd5\xc7\xee\xd4\x2a\xc9\x79\x2c\x22\x27\x88\x01\xbf\xef\xec\x58\xf6\xfa\x3b\x4d\x8b\x76\x63\x78\x73\x15\xfe\x65\x2a\xfe\x60\x2a\xbc\x8b\xd5\x52\x53\x74\x77\x09\x69\xf1\xe3\x8a\x62\xbb\xb1\xf8\xf4\x15\xc6\x26\x12\x6b\xea\xf9\x18\xaf\xa9\x04\xa4\x3b\xf5\xc6\xad\xf3\x6f\x97\x77\xce\xd4\x95\x9f\xab\x5d\x79\xa3\x28\x8c\xc3\xc9\xa2\xf6\xda\x89\xbd\x11\x7d\x5b\x8e\xf8\x01\x81\x2d\x61\x72\x04\x30\x69\x94\xa8\x95\x4c\x87\x37\x45\x47\x6d\x7d\x68\x09\xdb\xe7\xfc\x62\x1c\xcd\x57\xd4\x6e\x8f\xf8\x8a\xb6\x1c\x41\xcf\xa9\x04\x2d\x41\x5a\x7d\x0e\x8c\x86\x71\x83\xad\x39\x63\xf0\x1a\x96\xc5\x65\xec\xe4\x7c\x9f\xc7\xf4\x88\x16\x48\xdf\x99\x6d\x9a\x32\x0f\xb1\x3b\x07\xb6\x6e\xb1\xbc\x09\xe7\x86\xda\xc2\xc0\x2b\xb2\xb7\x24\xec\xc7\xc5\x74\x6c\xa9\xc2\x7c\x31\xce\x5c\x51\x92\x6c\xfe\x87\xab\x2f\x19\x21\xe4\x77\xf0\x3b\xa3\xb6\xd2\x6d\xe8\x8f\xf3\xea\xf1\xfc\x39\x8d\x7a\x41\xd2\x37\x18\x52\x74\x1b\x0a\x03\xa5\x66\x1b\x31\x81\x3a\x33\x9c\xd4\xea\x47\x5a\x41\xaa\x72\x5a\x58\x07\xf9\xdb\x67\xde\xa2\x22\xa6\xae\xd9\x40\xae\xb5\xa0\xb8\x92\x5a\x45\x75\xbf\x70\x31\xcf\x5e\xba\x74\xc7\x3a\x66\x6b\x9a\x17\x52\xff\xf8\xd3\x98\xef\xce\x15\xe0\xb0\xc8\x5f\x02\x01\xac\xec\x98\x77\x2f\x82\x52\x3b\xbb\x0a\x1b\x6d\x15\x7c\xdb\x70\xec\x52\xe7\x90\x56\xf7\x60\xa7\x0c\xdc\x81\x34\x48\xce\x7c\x78\xaf\xc4\xfc\x29\xd3\x20\x37\x5b\x07\xd9\xe4\x4d\xf7\xf7\x75\x9d\x36\x09\x7f\x9a\x3d\xaf\x90\xc3\x6e\xa6\xc6\x3d\x23\xcd\xdc\x71\x37\x8d\x03\x6f\x6e\x1d\x79\x53\x1d\xfa\xcf\xf9\x32\xa5\x19\x12\xa6\xf7\x4d\x11\xdb\x08\xbf\x08\xe2\x14\x86\x59\x4b\x90\xc4\x35\xb1\xc4\x0c\x92\x88\xee\x54\xbc\x29\xd0\x9f\xa4\xd0\x8c\x0c\x26\x3a\xdc\xe4\x74\x58\xa0\xf3\x4d\xaa\xf3\xa6\xa1\xf7\xe6\xff\xa1\x7b\xb9\x66\x45\x0e\x60\x9e\x7e\xd4\x3c\xcc\x3d\x6b\xc6\x4c\x95\xc5\xcf\x9a\x9f\x7a\x94\x8c\xbd\xd3\x44\x3a\x4f\x55\x8e\x9e\x78\x40\xfc\x5f\x3c\x71\x22\xfc\xcc\x47\xd9\xfa\xfd\xec\xd9\xcf\xcc\x8e\xa5\x29\x7e\x9e\x67\x8a\x6f\xd3\xec\x84\x66\x8b\x10\x42\x4e\x18\xd2\x85\x6a\x34\xb5\xfb\x68\x49\x46\x8b\xd5\x80\xf0\x54\x13\xf0\x67\x84\x7f\xb8\x33\x75\x7f\xc0\xaf\x33\xc8\xdb\xda\x78\x7b\x61\x84\x87\x1a\xdb\xbf\x34\xc5\x23\x0f\x82\x15\xa9\xc7\x73\x4e\x7d\x2d\x85\xa9\x68\xb4\xdc\x55\x9b\xdb\x34\x58\xed\xe1\xae\xda\x1f\xe9\x15\x84\x40\xd4\xdf\x60\xfd\x21\x1d\x17\xfe\x55\x02\x15\xf2\xbb\xe9\x33\x6e\xfd\xc7\x8c\x7d\xcc\xe1\x84\x41\x20\x54\x21\xc0\xa3\xee\x85\xd0\x03\x5a\xfb\x47\xfd\x15\xce\x73\x43\xff\x41\xb9\x31\x5e\x38\xbe\xcf\xbf\x5c\x1e\x42\xb1\x45\x13\x00\x26\x76\x9e\x31\x94\x41\x51\x62\xc4\x59\x62\x81\x07\x9b\xf8\x1c\x8d\x22\x11\xf0\xea\x39\xba\x60\xe1\x07\x1a\x83\x83\x39\x86\xb5\xf5\x35\xa3\xa2\x2f\xec\x28\x34\x6c\x93\x2f\x4c\x28\x5e\x65\x5e\xbb\x67\x25\xaa\xf5\xc4\x4f\x0c\xa3\x62\x94\xcb\x20\x35\x4e\xe0\x16\xbe\x62\x59\xd9\x8d\x4d\xd4\xbe\x88\x10\x5b\xeb\x10\xe3\xa7\x47\x85\x56\x85\x9d\xd7\xd9\x43\x3c\xd2\x91\x6b\x41\x97\x62\x63\x02\x65\x1a\x8e\x05\xb0\x8a\x7e\x41\x05\x75\x01\xdc\xa2\x6d\x0c\xeb\xce\x17\xa8\xc2\x17\x1e\xd6\xe5\x3e\x59\xac\x27\x2c\x12\x99\x63\xf4\xe2\xbc\x25\x6a\x6f\xcc\xeb\x43\x7c\xdd\xf0\xb5\x9d\x6b\xe7\x70\xe8\x77\x34\x3b\xee\x13\xfd\x81\x58\x4a\xdd\xb4\x05\xc2\x8b\x87\x50\x8c\x29\xbf\x5d\xfe\x0e\xb3\xb8\x16\xe4\xe5\x6c\x1c\x92\x93\xb7\x79\x7a\xbb\xad\xfc\x5c\xc8\x0e\x34\x17\xbf\xb2\xdf\xe9\xd9\x84\x4b\x78\x03\xcc\xfc\xbe\x44\x2f\x7e\x78\xfb\xfb\x3c\xcc\x9a\x67\x81\x54\x1a\xd3\x21\x26\x07\x7c\x98\xaa\x6e\x8f\xc2\x3e\x18\xe1\x33\x9b\x42\xd7\x90\xd8\xf4\xf5\xe6\x72\x5c\xf6\xac\x96\xc7\x42\xcb\xb0\x3b\x61\xdb\x8f\xd9\x4b\x8d\x63\xfd\xf8\x9c\x9d\x88\x3b\x5c\x37\x0a\xe7\x2d\xa6\xc9\xd2\xe0\x82\x8b\x5a\x0c\x6d\xca\x16\x33\x4f\x54\x1d\x2e\x78\x93\x24\xbf\x61\xab\x74\x3f\xf3\x68\xc6\x52\xc3\x31\xb9\x94\xfa\x5b\xcc\x9e\x6d\x38\x37\x97\xb1\x84\x96\x73\xbc\x18\x51\x8e\x88\x19\x70\x2c\x73\x33\x0a\x2c\x3b\xac\x83\xc4\x35\x0a\xfc\x74\xb1\x4b\xe9\x93\x73\x1a\xb1\x2f\x73\x78\x6e\xd1\x1c\x87\xfa\xf1\x39\x4f\x5d\x18\x5a\x3f\x44\x82\xbc\x7b\x07\x48\xa2\xf4\x3d\x5c\x52\x57\x9b\x65\x8c\x0e\x35\xcb\x20\x71\xb9\x19\xc5\xf1\xde\x9c\x75\xea\xb9\x31\xde\xbf\xd9\x83\xfd\x33\xda\xbb\x8d\xc2\x7b\x90\x96\x71\x00\x99\xd9\x3d\xc2\xe4\xa9\x3b\xb3\xb7\x4a\x65\xac\xcc\x45\xf8\xba\x96\x6e\x5f\xa5\x13\x97\xb5\x09\x03\x77\xe8\xde\x0d\x99\x8f\x06\x86\xcc\xf8\x11\xd3\xec\x6f\x4c\x0a\x61\xbf\x09\x90\xaf\x52\x28\x9e\xc8\x3c\x72\x91\xdd\xb2\x52\xfe\x50\xcc\xaa\xeb\x3c\xc1\xaa\x1b\xcf\x9d\x80\xa5\xf9\x74\x17\x3d\x11\x38\x16\x8a\x11\x28\xc0\x0a\xef\x9c\x08\x28\xb4\xb5\x57\x6f\xef\xcd\x9d\xf1\x18\x6f\x74\xee\xd5\xe9\x7f\x0d\xf7\xae\xbd\xc7\x7c\x6c\xf1\x35\xf5\xf3\x32\x25\xfa\x04\xe1\x54\x09\x87\x99\xc8\x7c\xa9\xeb\x16\xce\x23\xd0\xad\xc1\x99\x00\x2b\xce\x7d\x50\x6d\xca\x87\xfb\x87\x53\x52\xda\x77\xee\xe6\xf0\xb1\xa4\xb4\x44\x4b\xff\xb3\x0c\x17\x5a\xf1\x29\x2d\xf6\xf5\xc2\x0e\x2d\x9c\x62\xa1\x51\xfa\x64\x90\xd7\xe2\x4a\x8a\x39\xb0\x57\xee\xf8\x1b\xee\xd3\xf2\x89\x65\x26\x20\xe8\x60\x3c\x4e\xae\x4a\x4a\x02\x62\x88\xcf\xb2\xd9\x06\x18\x98\x08\x83\x67\x45\x5a\x80\xbe\x34\xe6\x70\x4d\x7c\xec\xc5\x30\xe6\x8d\x0d\xe4\x1c\xb8\xbc\x87\x49\xe8\x03\xf9\xb2\x2e\x7e\x88\xfc\x6f\x66\xbf\x8c\x30\x67\x40\x69\x56\x19\x9b\xea\x6d\x75\x63\x04\x01\x95\x46\x2b\x5f\x1f\x04\xb5\xfb\x4a\xb3\x0e\xd2\x29\x7d\xde\xc0\xf3\xac\xda\xac\xb7\xfd\x4a\x58\x69\x74\x78\x0e\xd7\x78\x14\xc1\xa0\xbf\x55\xf8\x23\xd5\x58\x68\x67\x20\x30\xf9\xb6\x5f\x3d\xae\x57\xc3\x6a\x39\xae\x06\x20\xf7\xd0\xe4\x48\x62\x2c\x26\x42\x4c\x83\x8a\xd3\x65\x16\x78\xd4\x26\x18\x57\x7c\xc5\xe4\x94\xad\x84\x56\x42\x66\xf0\x7d\x64\x37\x32\x90\xf7\x31\xf2\x66\xbb\x13\x6a\x56\x65\x37\xb9\x73\x43\x3c\x03\x4d\x73\x4d\x5b\xed\x3e\x51\xcb\xe6\x64\xd7\xea\x96\x32\x1b\xb9\x19\x03\xbd\x1d\x08\x56\x72\xfc\x7b\x67\x13\x97\x70\x57\x13\x3a\xe4\x6e\xa4\xe2\x97\xa3\xb3\x80\x6d\x1b\x98\x57\xd4\x16\xe6\xc6\xad\x60\xe2\x71\xe7\xf8\x3f\xd1\x56\xf8\x04\x05\x4d\x06\x1a\x5f\xa4\xf1\x6f\xd5\x66\x9c\x35\x6a\x2d\x80\x5c\xcc\x3c\x1b\xd3\x33\x3c\x0d\xc9\xda\x29\x83\x45\x8a\xd2\x1c\xe5\xcd\x16\x4a\x6b\x6f\x43\x55\x3c\x47\x81\x3d\x0d\xb6\xf8\xc6\x4e\x94\x95\xc7\x2b\x39\x75\xd8\x59\x06\x93\x28\x0a\xfa\x91\xa7\xe5\xbb\x47\x62\x5a\x63\xfd\x06\x17\x0d\xd3\x87\x50\x56\x27\x1d\xcf\xc2\x7b\x66\x23\xf8\x10\xbc\x15\x59\x70\x4a\x16\x20\xe5\xb3\x3a\x67\x7a\x25\x6e\x38\x14\xc1\xfd\x08\xcf\xa1\x23\x9c\x3f\x29\xa3\x4b\x8e\x1e\xa4\x58\xc6\xc2\x9d\x26\x68\xbe\x30\x91\x41\xf2\x0d\x16\x26\xcd\x20\xcd\x5c\xbb\x73\x87\xce\x0b\xba\x8f\xe9\xd5\xf4\x12\x88\x4f\x76\xa3\xed\x27\x5a\xae\xbc\xfa\x35\x66\x3d\x7c\x94\x82\x04\x70\xc6\x6b\x37\xf2\xf0\x3e\x35\x66\xfc\x6f\x8f\x59\xf2\x6b\x1f\x73\xfd\x96\x90\xf8\x42\x3c\xf4\xa8\xd8\x71\xb7\x74\x7a\x1b\x1d\x76\x4a\xad\xd2\x1e\x5e\x86\xd2\x47\xc2\x24\x7d\x45\x35\x85\xae\x30\x8d\x64\x79\x4e\xc6\xec\x42\x03\x46\xbf\x85\x6e\x4a\xa7\xb8\x3b\xef\xd1\x85\xb5\x7f\x41\x29\xb2\x7a\xcf\xa4\xbc\x3d\xb4\x92\xb7\xf7\x98\xf7\xe5\x5e\xa9\xc2\xdb\x55\x4a\xed\x5f\x3a\xa5\x0a\xb0\x35\x28\xf3\xd8\x20\x2b\xa5\xd3\x43\xec\xa6\x53\x92\x91\x0f\xc2\x47\x76\x6d\x7b\x51\x0b\xe9\x90\xe2\xaf\x9e\x7b\xcf\xb9\xfe\x90\x39\x51\x8e\xec\x65\xb9\x44\xa3\xc3\xab\x01\xdc\x42\x7b\x54\x76\xc8\x12\x86\x5c\x1f\xa0\x43\xa7\x3a\x67\x98\xab\xa5\x5e\xc5\x5e\x24\xb6\x4a\xe9\x6c\x32\xec\xf8\x14\xba\x1b\x18\x58\xde\xd7\x37\xa5\x4a\xd9\xef\x36\x2a\x3e\x9e\x7f\x89\x6b\x4d\xa6\x95\x04\x64\x43\x37\x05\x96\x4a\x0e\xbe\x5d\x64\xbd\xf9\xed\x27\x5a\x51\x62\x28\x5f\x52\xd5\x27\x26\xca\xe0\xc2\x1a\x24\x51\x76\x0d\x90\xc6\x7a\x5f\xd7\x36\x00\x9d\x87\x87\x95\x95\x5c\x50\xf6\xe2\x0f\xbf\x95\xd7\xf2\x36\x88\xf5\x23\xe6\x90\x61\xb8\x20\x6e\xec\xe5\xe2\xd4\x9a\x5e\x60\x41\xcf\xc5\x4d\x7f\x5c\xa3\x2e\x9c\x8d\x01\x39\xb7\xef\xb2\x2b\x41\x7a\xf6\x79\x99\x8d\x81\xdc\x11\xd6\x90\x7a\xd8\xda\xec\xb7\x6d\x4f\xba\xa5\x3d\x2a\x8b\xdb\xbf\xc8\x84\x54\xbf\xe0\x99\x62\x5b\x22\x56\xa9\x72\x5f\x29\x75\xf6\x4e\x6f\x3b\x1a\x9e\xe5\x20\x95\x27\xc6\x27\x11\xea\xf4\xf0\xb6\xd3\xda\xff\xdf\xc6\x09\x48\x7f\x95\x5e\x82\x67\x8f\x09\x9e\x11\x37\xc7\xef\x96\xa6\x18\xe7\x39\xba\x0d\x79\xc5\x01\xaa\xcf\xb8\xcc\x40\x53\x89\x53\xc5\x4d\x90\xf7\xfe\xdd\xd8\x89\x67\xed\x12\xbd\x93\xb9\xc0\x90\xc3\x6c\xb1\x4e\xed\x06\xaf\xf2\xcb\x29\x30\x33\x3e\x9f\x12\xdf\xf5\x5a\x20\xf4\xa3\x9e\x54\xbd\xf5\xc3\xd1\x9f\x20\xce\x0a\x45\x6d\x2f\x72\x7d\x07\xa3\xdc\xa2\x38\x4b\x63\xb4\xef\xd5\x9e\xbb\x6b\x29\xf0\x56\xa9\x36\xc6\xe4\x5d\x7e\xa1\x70\xaf\x31\x5f\x0b\xe1\xb7\x2a\x1a\x35\xe7\xeb\x3d\x50\x4e\x60\xdf\xfb\xa5\xe2\x56\x7e\x69\x97\x00\x40\x30\x8c\xce\x2f\xed\xb4\x77\x77\x1d\x38\x42\x9d\x85\x30\x45\x94\x63\xb9\x7d\xec\x85\x9a\xd4\x67\x56\xb1\x17\xfd\xe8\x5f\xa2\x8c\x22\x9e\xaf\x87\x2a\x2d\xcf\xaa\x0b\x9a\xb9\x92\xf8\x9d\x06\x33\xec\x89\x2c\x41\x4a\x47\xd3\x7e\x34\xc0\xae\x06\x87\x4e\x7b\x2e\x63\x61\x52\xd7\x9c\x1f\x0c\xdf\x1b\x64\x6b\xb3\x99\xd2\xac\xd2\xe0\xf9\xc1\x38\x8b\x45\x23\x89\xdf\x09\xa8\x75\x44\x7c\x7f\xde\x89\xa0\x07\xbb\x69\x85\x36\x74\xf2\x2f\xd1\x74\x00\xb2\x66\xf2\x8d\xee\xb4\x5f\x8e\x2a\x0d\x2b\x79\xdd\xaa\x93\x71\xc5\xfe\x7b\x16\x8e\x29\x2d\x55\x16\x99\x1f\x56\x23\xae\x94\x70\xf1\xf4\xf5\x2c\x81\x9c\x5a\x2a\xbe\xa6\xa5\xd4\x9a\x72\x14\x1f\xe7\x18\x5a\x68\x2e\x09\x96\xe9\x3b\x8c\x9e\x68\x6f\xf1\xe2\xcb\x8b\xe1\x53\x29\x84\xc9\x03\xa0\x4e\xd3\xe0\xe1\x34\x3a\xf2\x04\x74\x9e\xa1\x6d\x3a\xc8\xa7\x75\xe8\x25\x3f\xa1\xda\x5e\x85\xb7\x9e\xef\x9e\xbb\x2b\x0f\xdb\x1c\xde\xd1\xc7\x07\x27\x18\x47\x20\xc8\x1d\x4e\xbd\xf4\x38\x40\x64\xfa\xea\x46\x31\x8c\x5a\x68\xc7\xe2\x8b\x67\x54\xbf\x1c\x33\x73\xd0\xd3\x2c\xfc\x1a\xd0\x8a\x9a\xf9\x15\x55\x91\x8a\x89\x6c\x21\x60\xff\x11\x53\xfb\x82\xd6\x81\x8b\x35\x4f\xb9\x00\x64\x96\x2e\x62\x15\xe5\xd6\x31\x64\x82\x8c\x36\x18\x3c\x7f\x63\xf7\xce\xe8\xec\x12\xf0\xc2\xd2\x6b\xce\x57\xea\x61\xdc\x50\x57\xe1\x23\x90\x51\xdc\xe8\x1a\x9d\x1a\x46\xef\x9c\x0d\x48\x90\x06\x6d\x9e\x55\x52\x42\x6f\xb3\x9a\x05\x25\xb9\xdb\xa9\x98\x65\x62\xa7\x9b\x18\xca\x90\x02\x30\xfc\x38\xbb\xad\xa9\xbe\xa1\x34\x63\x7e\xe5\x61\x78\x6b\xbc\x95\x0a\xf3\x4d\x04\x45\x2d\x1b\x3e\x07\xa0\xc6\xf3\xcd\xcb\xa3\xec\xd4\x2c\xbb\x7f\x2a\x9b\x3f\xbf\x53\x6e\xee\x50\xef\x09\xd3\xdd\xb0\xe8\x0c\x6e\xea\xc0\x2b\xb5\x56\x19\x70\xb1\x0a\x67\x14\x3c\x2e\x9e\x6b\x99\x51\x46\x5f\xfa\x0b\x05\x0a\xa2\x05\x7b\x95\x80\x4d\xe9\x2b\xf0\xd4\xf8\xf4\xb2\x5c\xc5\x36\x27\xf0\xee\x90\x64\xb0\x3d\xbb\x1e\xaf\x9b\xb9\x7a\xfc\xfd\x1e\xe6\xb1\x89\xf7\xe0\x0b\x7b\x11\x22\xe7\x5e\x2c\x48\x05\x8d\x60\x41\xb8\x00\x79\xf0\x6e\x0e\xe8\x05\x02\x69\x7b\x0f\xb8\xa7\x73\xeb\xa3\x61\x4c\xeb\xbf\x26\x4e\xd2\x25\xfc\x98\xf1\x8f\x8d\x26\x2e\xff\xd0\x6a\xb7\x30\x15\x2c\x08\xef\xf9\x44\x5e\xe7\xf7\x79\xc7\xe3\x9b\x90\x27\xf4\x49\xad\xa6\xf2\x4a\x5d\x01\xc6\xfb\x18\x30\xb8\x22\x36\xb4\x53\x38\xc2\xcb\xdb\x8b\xac\x8a\x26\x51\x3d\x6d\x77\xd2\x91\xde\xf8\x36\x85\xfe\xc6\x3a\x69\x42\x48\x4d\x8a\x59\xaf\xde\x44\xe1\x5d\xb1\x99\xb5\x75\x83\x57\x76\x0a\x99\xb7\x93\xad\x6f\x0d\x53\xc8\xd4\xc9\x4c\x81\xa4\xa0\x9d\xa5\x61\x8d\x7d\x65\x75\xf0\xdd\x3c\xd2\xe4\xc9\x92\x69\x1a\xa9\xcd\x84\x5f\x49\xe5\x38\x03\xe2\x34\xbf\xcc\x10\x30\x43\xdd\xaf\xa8\x83\x79\xbe\xb7\x40\x82\x14\xd6\x0f\x18\x87\xf7\x97\x2b\xe9\x0d\xd9\xb0\xda\x55\xd9\x32\x7e\x2d\xc3\x78\x8b\x7d\xf2\x19\xe7\x1b\x67\x98\x87\x09\x48\x8e\xc2\x45\x1e\xe6\x53\xe3\xa3\x89\x39\xe0\xa0\x32\x5f\xcc\x8c\x2c\xf3\xc5\x3c\x5c\xdb\xbe\x38\x28\x41\x2e\x2c\x33\x19\x77\x75\xc4\xe0\x0c\x4f\x3e\x2b\xde\xac\x20\x6d\x67\xc8\xbd\x95\xb3\x33\xca\xcc\x56\x7a\x6f\x1c\x90\x69\x0a\x2a\x4b\x4b\x8b\xca\x03\xda\x31\x8b\x44\x05\x1b\x6c\x59\x99\x36\xb7\x73\x0c\x41\x8c\x76\xf9\x52\x23\xdb\x69\x28\xc1\xfd\xd2\x78\x8d\xd8\x90\x77\x81\xd6\x14\x32\x0f\xc0\xc9\x0f\xe0\x69\x36\xf3\xcf\xe8\x8b\x88\x46\xed\x85\x62\x8c\x71\xa4\x31\x46\x35\xe7\x38\xc2\x2d\x76\x21\xac\x31\xb5\xfb\x03\x0f\x9e\xb8\x43\x29\x66\xd3\xf3\x64\x13\x59\x45\xef\x29\xa9\xcb\xca\x1b\xcf\xd0\xb2\x07\x7b\x23\x26\x2e\x28\x7b\xc4\x63\x77\x83\x52\xe0\x92\x51\x81\x50\xc5\xf4\x43\x9e\xa2\x96\x38\x18\x34\xc9\xbc\xfd\xe6\x5c\x1d\xc1\xfc\xa4\x59\xb4\xd2\x2e\x8b\x73\x54\xd0\x76\x32\x43\x70\xa0\xe6\x81\xb1\x5a\x72\xf7\x43\xec\xa1\x94\xdb\xd1\x74\x4a\x3c\x2d\x25\xcb\x45\x59\x41\xbf\x5c\xa7\x72\x4c\xee\xe1\xe9\x9e\xcc\x5a\xc5\xd8\xc9\xa3\xb8\xb0\x2c\xb9\x2c\xd1\x1f\x47\x34\xbd\x70\xea\xcb\xf2\x9a\xf3\x44\x6f\x35\xd9\xde\xca\x24\x77\x48\xb1\x62\x8b\x94\x26\x70\x4d\x6d\x21\x98\x83\xac\x65\x3a\x52\xa3\x53\x86\xed\xbf\x2a\x6a\x95\xd2\x8d\xf2\xbd\x9e\x8d\x15\xb9\x67\xe5\x2b\x03\x90\x0c\x14\x91\xdd\xbf\x74\x49\x73\xdb\xbc\x26\x85\xe6\x35\xd1\xe7\x35\x29\x3a\xaf\xc9\xd6\x79\xa5\xdf\x8e\x96\x51\x0c\xea\x59\x89\x27\x07\x2a\x19\x16\x3b\x77\xf6\xc9\xfe\x6c\x94\x3a\x55\x31\x31\xab\xe1\x69\xa2\xf4\xf6\x23\xd5\x6c\x83\x5d\x30\x30\x55\x4e\x0e\x8d\x59\x40\x1c\x71\x72\x9c\xdb\x40\xb8\x6c\x3b\xfe\x7c\xe6\x94\x43\x8c\x8a\xb9\xd8\xd8\x75\x6b\x4b\x13\xfd\x78\x21\xbf\x9e\x80\x3a\x4b\xf4\x95\x5a\x98\x8c\x20\xa6\xf5\x60\x84\xb5\xba\xb5\x66\xf7\x46\x0c\xc6\x48\x1d\xca\x31\xff\xf8\x16\x8c\x43\xe1\x7a\x46\x7b\x49\x98\xb0\x11\xc4\x69\x5f\x64\x59\xb2\x92\x5b\xb4\x2d\x8e\xd8\xe5\x3b\x31\xe7\x51\xe8\x57\xd9\x66\x54\x22\x54\xc3\xdf\x7b\xb1\x45\xc7\xef\x96\xc5\x16\xf2\x22\xd9\x3e\x1a\xc7\xb2\xd7\xdc\x55\xd5\x42\x20\xd9\xa5\x86\x34\x63\xfc\xef\x11\xfd\x5f\xc9\x6a\xc9\xae\x5f\x29\x5d\x9f\x60\xd3\x68\x04\xe0\x71\x16\x4e\xcb\x43\xb0\x1d\xce\x83\x69\xfb\x16\x38\xd9\xc9\x31\xf1\xbe\xbe\xfe\xf0\xf9\xbe\xfe\xdb\xaf\xd3\xb0\x07\xff\x7b\x7f\xfd\x65\x76\xf1\x65\x8a\x3f\xff\xc4\x3f\x9f\xce\x7a\xdf\xe1\x9f\xf3\x8b\xeb\x37\x5f\xcf\xb1\xa0\xf7\xed\xfd\xf5\xe7\xfa\x65\x2f\x8a\x8f\x47\x27\x9f\xb0\xe0\x0f\xef\xba\x3e\xbe\xe8\xbd\xef\xf5\xfe\xba\x3f\xff\x78\xfc\xc7\x8b\xdf\x5c\xda\xde\xbf\xbf\x7e\xe3\xff\x05\x3f\x3e\xac\xb1\x87\xf8\xd3\x6b\xff\x3a\xfe\xf0\xeb\x3d\xbe\x1b\xd7\xaf\xbf\x34\xde\xbc\x7e\x15\xbf\xfd\xf5\xbe\x3e\xba\xfa\xcf\x78\xd1\xf8\x42\x5f\xfc\x31\xbe\xf8\xfa\x7d\x7c\xf1\x7e\x75\xfb\x7b\xc3\xbf\x0d\x3e\xf5\x3e\x1d\xfd\xdb\xff\xfe\xed\xb3\xff\xc7\xd9\xeb\x23\xe7\xdb\xe7\xf0\xf2\x62\xfc\xef\x9b\xaf\xaf\xbf\x4d\x3f\x1d\x7f\xc3\x06\xce\x85\x7f\xf1\xe9\xeb\xa7\xf0\xaf\xca\xd1\x75\xfc\xf9\x4d\xef\xd3\xeb\xb3\x37\xc7\x7f\xbc\xfa\xfd\xdf\x57\x2f\x7f\x3b\x3b\xff\x7a\x1f\xde\x07\xfe\xc9\xf7\x6f\xf1\xcd\xdd\xd9\x97\x78\xf3\xdb\xaf\x7f\xfe\xf1\xd7\xc8\x7f\xf1\xd7\x9f\x7f\x1d\x7d\x7f\xf7\xe7\x66\x3e\xed\x05\x91\xfb\xe6\xee\xfc\x62\xfe\xf6\xcf\xcb\xfb\xaf\x1f\xae\x26\xf3\xb1\xf7\xea\xec\xe2\xe2\x7d\x33\xf8\x75\xfe\x66\x7c\x7f\x5e\x3f\xda\x5c\xfc\xe7\x7c\xf1\x61\x7a\xe6\x2c\x83\x17\xf1\x7f\xae\x6f\xce\xdf\x1e\x35\x2f\x1b\xf3\x4f\xbd\xe6\xc7\xdb\x57\xf1\x1f\xee\xa8\xb7\x1e\x3d\x8f\x8e\x2e\x7b\xb7\xcd\xc6\xf8\xe4\xe5\x8b\x97\xeb\x98\x42\xaa\x77\xfd\xe5\xeb\x87\xcf\xbf\x3d\x3f\xfb\x7e\x79\x69\x4b\xb2\xd7\xed\x32\x28\x50\xb2\x45\x3b\xb0\x9b\x72\xd5\xe0\xb7\x65\x56\xeb\x17\x39\x3a\x3d\x12\x02\x90\x28\x8d\x27\xf6\xac\x91\xa2\xa9\xb4\xc6\x9a\xa1\xa8\x24\x51\x13\x51\x8e\x71\xc8\x98\x2c\xc9\x88\x8a\x0c\x64\x23\x59\x1a\x41\x09\x6d\xc5\x8c\x08\x13\x4c\xb9\xbf\xc6\x3f\x77\xf6\x33\xf3\xf4\x9e\x19\xf5\x6f\x72\xce\x22\xaa\x8a\x74\x60\x6f\x22\xa0\xe0\x1b\x27\x9a\xb7\x4d\x9e\xc5\xa3\x44\xc8\xa1\xe2\xca\xc5\x7a\x01\xa2\x26\x0d\x09\xe0\xd8\x65\x17\x73\xd2\xba\x68\x36\x3d\x1c\xa9\x5b\x38\x48\x16\x9e\x8d\xe5\x95\xf2\x82\xc2\x91\xc1\xea\x63\x18\x57\xf5\x7a\x6b\xcc\x72\x14\xc9\xaa\x14\xb6\xdb\xea\x72\x13\x58\x1f\x00\x36\xd0\xcd\xe1\xd2\x30\x22\x77\x4b\x9a\xa9\x8f\xc5\xde\x5a\x58\x08\xaf\x3a\x61\x8b\xe5\xe1\x85\x6e\x1a\x86\x61\xe1\x44\xb0\x11\x75\xc5\x8f\x16\x25\x57\xbe\x63\x10\x1e\xfb\x0b\x73\xa0\xd1\x2c\x5d\x35\x5c\xd0\x87\x07\x14\xed\xa6\x34\xbd\x59\x52\x6a\x29\x81\x53\x41\x98\x67\x1f\xdd\x68\x19\x18\x95\xdc\x68\x99\x1a\x98\x78\xd1\x03\xc9\x6d\x94\xbb\xa5\xaa\x8c\xee\xbc\x36\x42\xe7\x23\x58\x81\x67\xa9\x6c\xab\x2c\x8f\xe8\x84\x9b\xed\x9f\x35\xda\x66\x58\xc8\x33\x58\x0e\x8a\xea\x10\xb5\x99\x24\xa3\x92\x75\x7a\x2c\xba\xa8\xb7\x15\x90\x31\x0f\x14\x58\xd9\x11\x6e\xdf\x09\x09\xa0\x26\xb8\x64\x37\x6f\x6c\x78\x97\xa2\x9b\x2e\x88\xbe\x9e\x8e\x04\x15\xf4\x3a\x93\x69\xa4\xa1\xc7\xf4\xc2\xaf\xb8\x97\xd6\x91\x25\x2b\x3a\x6b\xa8\x98\x46\x08\x4c\x75\x6b\xa7\x5b\xa7\x7b\x4f\x35\xaa\xa4\x30\x35\xf5\x89\xf4\x50\xf9\x50\x2a\x47\x96\xc8\x93\xca\x4b\x0e\x9b\xc2\x1e\xbb\xd2\xd4\x95\x6a\xc0\x54\x8c\x51\x9e\x42\x4a\xee\xf6\xf7\xc5\xea\x79\x59\xbb\xed\xde\xa4\x5b\xa6\xc4\x7d\x5e\x83\x57\x7c\xa5\x4d\xe9\x41\x73\xf1\xcb\x54\x89\xa1\xd8\x16\x0c\x93\x42\xdb\xdd\xc3\x03\x1b\x9d\x45\xcd\x55\x9a\xb9\x1d\xbd\xc9\x04\x5f\xc8\x41\x01\xc0\xa4\x51\x46\x77\x47\xda\x73\x79\x66\x56\x77\x2c\x6f\x7b\xa2\x02\xa8\x30\x98\x71\x88\xef\xbf\x61\x10\x65\xe8\xca\xc1\x53\x51\x07\x23\x7b\xb0\x9b\xcf\x4a\x45\x35\x0f\xe6\xc4\x0b\x1c\xdf\xdf\xfc\x30\x7d\xb4\xf1\xa8\xc7\x4a\xa5\xf4\x31\xca\x70\x44\x4b\xda\xd5\x13\xea\x47\x44\xd6\x64\xa5\xb6\x98\x77\x0a\xbd\x11\x0e\xaf\x51\x66\x40\x17\x73\x4a\x27\x88\x57\x94\x3d\x32\x8c\xa8\xb8\x12\x5f\xb0\x79\x7a\xfb\xc8\x6b\xdf\x16\x8e\x2a\xa9\x3e\xe4\x58\x39\x51\x76\x1c\xb4\x13\xf1\x87\x53\x2f\x93\x0f\x97\xd1\xf1\x7a\x7f\x1f\x1f\xf7\xf7\xf3\xd6\x4d\x2e\x4e\xb7\x9c\xc3\x40\xd7\x69\x06\x6a\x62\x8f\xbb\xf9\x60\xbc\x83\x0f\xfa\x56\x82\x7b\x71\x86\xb7\xad\xff\x59\xde\x96\x8b\xd6\x40\xa8\x8e\xce\x18\xd0\x55\x39\xc5\x78\x00\xd7\xa2\x2a\x66\x4a\x70\x4f\xed\x14\xd3\xe9\x96\xbd\x74\x11\x74\xe0\x55\x02\xab\x15\x55\xdc\x8e\xbd\x83\x47\x75\xcb\xd1\xae\x2a\x30\xc2\xa8\x0a\xdd\x95\xbd\x8a\xed\x92\x08\xfe\x88\x30\x49\x59\x94\x4d\xe1\x52\xb6\x82\xc2\xcf\xbc\x6a\x28\xf8\x59\x06\x75\x95\x6a\x51\x52\x6d\x0b\xdb\x9b\x71\xb6\xe7\x1b\xd8\xde\x1a\x93\x06\x3e\x6b\x14\x60\x75\xf1\x6e\x56\xe7\x5b\xc8\xc7\x66\x0a\x1f\x9b\xa5\xf9\x98\x99\x4b\xa9\xec\x08\xf8\x10\x4b\xe6\x0f\x78\x52\xce\xc3\x0c\x4b\x6b\xa2\xe5\xe1\xdf\xc9\x9b\xe6\xd9\xbd\x1b\xa4\xb6\xb5\x90\xbd\xb0\xb4\xcb\xf4\xba\x96\xe4\xcf\x6d\xf7\x99\x9d\xc7\xc5\xf1\xac\x23\x8f\xc1\xbb\xe8\xe6\x3e\xd5\x3e\x58\x4a\x72\x39\x97\x12\x02\xa6\x89\xf3\x99\x3c\xa4\xe7\xcd\xef\xba\xe5\xd4\x0b\x14\xfc\x12\x87\x0d\x9d\x10\x5b\x25\x99\xe7\xb9\x50\xe7\xce\x93\x3a\xa7\xd9\x52\xc9\xf8\xff\x34\x9d\xf0\x9f\x9c\x4e\xfc\xa4\xce\x7d\x3a\x9d\x65\xea\x60\x2e\x49\xca\xd2\x57\xe7\x46\x4a\x22\x03\xb6\xf8\xc9\xc8\x82\xfd\x66\x3d\x97\xf0\x02\x4c\xbd\xed\x25\x11\xb8\x31\x7f\x8b\x82\xa9\x99\xf0\xb4\x0b\xd8\x6b\xbd\x01\x3b\x3c\x64\xde\xd4\xa9\x53\xc3\x72\x29\x9d\x5a\xba\xf4\xb4\x9c\xc2\x56\x6e\xc7\x73\x27\xb8\x18\x4f\xdd\x37\xbc\x76\x89\x34\xdc\xea\x31\x4f\x01\x6a\xab\xc7\xf2\x5d\x65\xcf\x69\x29\x82\x78\xca\x1c\x9b\x9d\x9c\x41\x9f\x23\x3d\xe2\xa6\x0f\xef\xcc\x0d\xd3\x7c\x4f\x69\x99\x16\x25\xba\x45\xfa\xd3\xe4\x0a\x75\x1b\x25\x21\x1a\x12\x0a\xf4\x90\xd8\x53\xf5\xd6\xe4\x67\x1a\x33\xec\x99\x0b\xcb\x71\xd6\xe9\x01\xf3\x8e\x98\x41\x38\xb5\xc8\x32\x07\x46\xc9\xab\xe4\x7b\xe3\xf4\x61\x4c\xfa\x1c\x29\xe7\x2c\x46\x33\xa0\x52\xc3\x93\xeb\x44\x54\x4c\xaa\x93\x7a\xc6\x7e\x0c\x7b\x61\xa6\x68\x66\xb5\x91\xe7\xcb\x73\xa2\x2b\xd0\xcb\xd1\xa2\x8f\xc7\xab\xec\x3a\x84\x9b\xba\x0e\xe1\xb2\x13\x01\xc7\xae\x3d\x6f\x67\x07\xa1\x5c\x81\x6a\x50\xfb\x50\x72\x8d\xaa\x04\xc0\xdf\xa0\xd1\x48\xbd\xbc\xb4\x90\x61\xab\x50\x50\x5f\xc8\x40\x55\x86\x91\x56\xd5\x0a\xd9\xb9\x55\x7f\xae\x0d\xad\x20\xa3\xfe\x64\x16\x41\xc2\xa3\xc0\x69\x98\x76\x40\x80\x8d\xde\x78\x3e\x0f\x4b\x89\x57\x5a\x76\xd7\xbf\xa6\xc3\xe0\x2d\xe8\x41\xda\xc3\x83\xbc\x1b\x90\x3d\x7c\x5b\xb8\x73\x6c\xc5\xae\x22\xd1\xb9\x85\x77\xf3\xe5\x02\x3a\xb8\xbb\x45\x47\x1c\xe6\xef\x07\x88\xfe\xce\xbb\xf3\x16\x54\x5a\x03\xd1\xaa\xb6\xb9\x72\xd6\x55\xfc\xc7\x0b\x40\x30\x33\x9c\x56\x84\xb0\xb4\x78\x59\x54\x23\x99\xb4\x91\xc3\x4f\x74\x43\x8c\xcc\x55\x45\x3e\x8e\xec\xe9\xa8\x8e\x4e\xa6\x26\x58\x87\xd6\xa1\x0f\x3b\x7b\xd9\xb0\x4a\xf0\x2a\x42\x17\x5d\x43\x23\xd8\xcb\x4c\x0d\x60\x4f\xa0\xd6\x1e\x9e\x7d\x3a\xd0\x90\x2a\x10\x48\x05\xad\x93\xe4\x04\x1b\xe0\xfb\x9b\x53\x0f\xc5\x17\xc9\xfc\x37\xc2\xef\x12\xe3\x50\xe2\x0b\x96\x7c\x9a\xdf\xdc\x1a\xd2\x48\x85\xf8\x97\x4e\xcf\x3a\x98\xb5\xea\x87\x75\x32\x49\xde\x37\x06\xdd\x71\xb5\x8c\xff\x72\x88\x5a\x07\x73\xac\xd3\x2e\x3b\x0f\x0f\x3c\x39\xf5\xf2\xe1\x41\xb9\x96\xbb\xb2\x9e\xd9\xca\xe3\x92\x06\xc9\xf3\xe2\x37\x68\x0f\xc3\x4c\x3e\xfb\xfb\xf2\x61\x62\x75\xcb\xa2\x8f\x6e\x20\xb0\x78\x05\x73\x6a\x81\x6e\xa3\x14\x8c\x70\xca\xf2\x69\x82\x99\xc1\xa1\xd0\x9e\x00\xd3\xe4\x23\xc5\xd4\xde\x0e\xf4\x96\xd7\x06\x18\x50\x4b\x3e\x2e\xf1\x11\xfa\x60\xb0\x65\x51\xe0\xe4\xcb\x29\xe6\xee\x09\xb4\x4b\x85\x0b\xe1\xba\x6a\x60\x07\xcc\x74\xce\xf2\xc1\x83\x00\x38\x46\x9d\x82\x32\xa7\x3a\x2e\xcd\x1a\x39\x31\xc5\xf7\xeb\x45\x38\x87\x72\x6e\x59\x06\xa5\x4a\x7f\xd3\x40\xaf\x53\x43\xf7\x13\xa0\x30\xc6\x5d\xd6\x30\x28\x7c\x02\x22\x76\xc5\x89\xb8\x5e\x57\x8b\xe8\x4e\xb6\xb3\x2e\x20\x80\x40\x09\x83\x97\x3a\x0e\xd8\x4e\x66\x06\x4b\xa6\x4e\x4b\xc4\x40\xcc\x49\xae\x78\x7a\xf1\x27\x58\xde\xc1\xe4\x97\x77\x41\x4c\x89\xd6\x55\x7d\xd7\x81\x68\xd1\x4e\xd9\x8b\x80\x9d\x96\x3d\xf4\x2d\x07\xa5\x8f\xa6\x7e\xb2\x1b\x6d\xaf\xb3\x48\x92\xd3\xc4\xe6\x13\xfb\xcb\x40\x3f\xb3\x27\x51\x7f\x31\xb0\xda\x98\xf0\xc4\x06\x5d\x8d\x61\x0c\x75\x2a\x46\x87\x7a\x5c\xfd\x67\x21\x4b\x5b\x45\x93\xe2\x04\x6a\x12\x1c\xda\xe8\x59\x9d\x47\xc0\xe9\x0f\xd0\xc1\x9c\x12\x12\x8f\xd0\x8f\x57\xc9\x5d\xea\x3b\x45\x6f\x7c\xc5\x43\x39\xd2\xc5\xa9\x3e\x4d\xda\x1d\x62\x1a\x0e\xe6\x07\xbb\x3b\x3e\xab\xb9\xc0\x71\x40\xf8\xe1\xae\xcb\x6e\x0d\x58\xf2\x39\xf4\x3e\x24\x0b\xd8\x98\xdb\xf0\x1c\xfa\xfe\x47\x78\x19\x8e\xcb\x56\xa7\x41\xd3\x97\xcc\x68\x21\x08\x1f\x3d\x90\x24\x30\xc2\xcf\x94\xe8\xf5\xe8\x96\xee\xb3\xe8\x78\x53\x8b\xbb\x9a\x72\x27\x59\x36\x45\x1f\xaf\x1a\xa9\xa9\x7e\x64\x82\x13\x1b\x34\x21\xf8\x3f\xe3\x25\xbe\x81\x8d\xf8\xc0\x41\x60\x06\xc0\x11\x44\x72\xfa\xa1\x08\x88\x39\xc4\xcc\x26\xa3\x4a\x85\x2c\x2b\xf6\xd0\x7a\x1c\xb3\x11\xf4\xf1\x63\xd8\x02\xf8\xe7\xf2\x70\x34\x60\x03\x5a\xd9\xef\x97\x77\xb7\x6e\x54\xbb\xea\x7d\x63\x39\x0b\x81\xed\x54\xd3\x65\xca\x90\xc7\x99\xd4\x44\x6b\x7b\xac\x0c\x04\x9d\xc9\x15\xde\xb2\x46\xb6\xf3\x0c\x98\xd4\xba\x83\xe9\xbc\xca\xab\xc4\x30\xb8\xc2\xec\x04\x93\x84\xbd\x4f\xe0\x99\x0d\xea\xce\xae\x35\x9f\xd3\xc4\x3f\x14\x1d\x26\xf2\xd0\x12\xb0\xb7\x51\x07\x7e\x45\x26\x15\x7b\x72\x70\x07\x1c\x54\x7f\xb3\x02\xe2\xcd\x8e\x92\x0f\x2f\x55\x97\x97\x5a\xc9\x75\xd7\x73\xd2\xb3\x27\xd5\x55\xfb\xdc\xee\x9d\x4a\xb0\x5c\xbe\x67\x20\xe8\xc2\xf7\x5a\x3d\xf8\x26\x7c\xfa\x9c\xac\xaa\xf6\x39\xf7\xcd\xf8\x41\xcf\x96\xc6\x04\xb9\x72\x6b\x45\x70\xd7\x6b\x4d\x52\x94\x9c\x71\x99\xc9\xd9\xe5\x73\x77\x41\xd7\xf4\x9e\x52\x17\x35\x74\x2d\x70\x63\x40\xef\x69\x20\x6a\x09\xcf\x3a\x29\xa3\x59\x9f\x79\x55\x1f\x3a\x48\xdf\xea\xbb\x05\x3b\x3a\x68\xb0\x77\x41\x76\xf7\x5b\x57\xb2\xfb\xe4\x81\x87\x79\x87\x0a\xd5\x2c\x37\xaa\x11\xde\xc8\x93\xdf\xcc\xd4\xd9\x64\x05\xa9\x4d\xc5\xb4\x6d\x9b\x44\x5f\x7e\x5a\x64\x1d\x36\x51\x46\x30\xd6\x10\x56\x16\xe3\x4b\xc5\x74\x12\x54\xf5\xab\x75\xa6\x8a\xe8\x1e\x13\x2b\xb5\xb6\x99\x62\x42\xad\xbf\x9c\x9a\x34\x6e\xe0\xd6\xef\x6d\x1b\x8e\x7a\x36\xcb\xef\xec\x6c\xff\x9c\xa9\x41\x56\x0a\xdd\xea\x2f\xa6\xe0\xe9\xe4\xef\xd0\x07\x98\x8d\xa5\x21\x76\x29\x5d\x1e\x73\xa5\xac\xa9\x61\x02\x66\xf7\x12\x7b\x4d\x8e\xf1\x3b\xa5\x0c\xdc\x02\xe9\xfd\x89\x81\x4e\x75\xe3\x77\x90\xe0\x25\xf4\x99\x3a\x22\xcb\x22\x38\xee\x7e\xca\x89\x46\x94\x3e\x2f\x31\xb4\x00\xb0\x24\x52\x43\x29\x9a\xde\x3a\xe5\xe6\x71\x9d\xec\x25\x7f\xea\xb5\x13\xf4\x3f\xa0\xd5\x24\xf0\x02\x13\xac\xd4\x4a\xa1\x11\xc2\x20\xa9\x9a\xdb\x19\x95\x20\x97\xea\xcf\x42\xd4\x0a\x52\x4f\x9e\xf2\x14\xa6\x9e\x5c\x4d\x79\x72\x55\xa5\x26\xcf\xdb\xb8\x9c\xeb\x39\xc1\x0e\xec\x43\x71\x60\x93\x5b\x8f\x51\xe9\x8e\x4a\xfc\xcc\xdf\xdb\x51\x4d\x7a\x75\xb0\x5b\x18\x25\x7e\xb8\x51\x08\x50\xae\x06\x0c\x27\xf5\x64\x02\x86\x3a\x80\x6d\x90\x48\x5d\x65\xcd\x78\xa2\x67\x70\x3d\x87\x30\x73\x58\x20\xec\x1f\x34\x38\xf7\x1b\x3f\x74\xcc\xc6\x18\x05\xd2\x56\x65\x01\x34\x99\xae\xbf\x85\xe1\x41\x83\xb6\x08\xdc\xaa\xd2\x51\x0b\x28\x4b\x23\x93\x16\x80\x8c\x53\x61\xcb\xad\x36\x3a\x59\xaa\x79\x78\x70\x2a\x8d\xd3\x42\x1b\x4c\x26\x5a\xee\xbb\x2f\xbf\x5e\xbe\xbf\x66\xc2\x8e\x39\x50\x88\x31\x9b\xd0\xf6\xbb\x2e\xdb\x82\xf1\x6e\xcb\x74\x69\x0c\x26\x95\x0c\xf6\x3c\x91\x5c\xf5\x30\x27\x86\x0a\x34\x9d\xdf\xf6\x2b\x37\x86\x56\xc0\x7e\xbe\xd9\xe8\xcc\xf3\x9d\x1a\x48\x2e\xbe\xdd\x7c\xee\x5d\x53\x37\x8b\x04\xb1\x34\xa1\x37\x3f\x8a\x56\x4c\xdf\xdf\x84\x2c\x3a\x80\x72\x99\x3b\x9d\x41\x5a\x46\x58\x72\x85\xf8\x55\xa9\x04\x22\x3f\x34\x86\xbf\xc0\x4c\xc9\x18\x5e\xdb\xb7\xb9\xcc\x1a\x77\xf1\x47\x6b\x51\x53\xd0\x2d\x46\xc5\xfe\xc7\x9a\xaa\xc0\x1b\xfa\x77\xbd\x72\x52\x75\x42\x94\x69\x08\xde\x17\x6c\xf9\x04\xaf\xf1\x21\x6a\x8d\xd7\xad\xa0\xe2\x3d\xb6\x23\x86\x03\x33\x4b\x0b\x67\x11\x06\x6c\xfc\xc2\x49\x18\xf9\x3e\xc8\x44\xfa\x54\xd3\x95\xf2\x81\xa2\xcb\xfe\xf9\xf5\x30\xc0\x0a\x93\xe3\xbe\xb3\xab\x9d\xf9\x55\xc3\x00\xf5\xd7\x0b\xbc\x1c\x89\x1f\xcf\xaf\xc8\x15\xc3\xef\x74\xbb\x9e\x87\xbe\x93\xbd\x55\x92\x38\x00\xbb\x4c\x36\x04\xa8\x33\x37\x0f\x26\x27\x06\xb6\x77\x18\xc1\xa6\x56\x76\x98\x7c\x78\x10\xc8\x83\x4d\xa8\x5a\x09\x33\x93\xa0\xee\x61\x6e\x7c\x19\x88\x13\x19\xdd\x54\xce\x6c\x47\x75\x1a\xac\x86\xad\x7d\xb5\x41\x13\x4f\xa9\xf8\x01\xbb\x1b\x1d\x02\xc6\x73\x40\x7d\x21\xb9\x40\x57\x05\x95\x0a\xaf\xe7\xc1\xcb\xd3\xa0\x6d\x39\xa0\xbc\x44\x95\x0a\xbf\x4e\xa8\xf4\x18\x75\xea\xb2\x62\x27\x6c\x5b\x5e\xb5\x4a\xa2\x6a\x55\x2c\xb4\xd7\xb1\x9d\x6e\x1f\x50\x61\xd0\xea\x23\xee\x8b\x76\x2c\x77\x8f\x44\x1f\x93\xd7\x0a\x47\xc8\x45\x17\x10\xae\xb5\x78\x44\xce\x92\x47\x73\x26\x1a\xad\x71\x93\xba\x89\xa8\xf3\x89\x34\xdd\x4c\x0d\x19\xa4\x39\x09\x29\x6d\x8a\x50\x30\x47\x80\x24\x4f\x6a\x7f\x40\x8f\x4b\x61\x1d\x35\x13\x00\xa3\xd6\x45\x26\x1a\x18\xc8\xf8\x2c\x28\x14\x9a\xe7\xf0\x97\x0b\x8b\xb5\xbf\x5f\xef\x50\x75\x3d\x66\xf6\x1a\xe2\x71\xc5\x32\x24\xf1\x40\xd2\x9a\x57\x8c\x4c\x28\xd2\xb8\x89\x4c\xe5\xca\xd5\xb2\x84\x97\x8b\x8c\x26\xd7\xa7\xd7\x45\x1b\xb6\xed\x4a\x47\x01\x8a\x19\xe8\x06\xee\x24\xa3\x77\x84\x02\x0d\xb3\x02\x0c\xab\xeb\xba\x90\x53\x75\x2b\x0d\x0b\x2a\x35\x3a\x2c\x97\x3e\xe0\x55\xdf\x43\x0d\x90\xf0\xa5\x8f\x84\xfe\x1c\xa1\xfe\x1c\x02\x0a\x06\x15\x51\xc7\x6a\xc7\x7d\x67\x60\x87\xdd\xfe\x02\xfe\xa5\x04\x74\x18\x02\x92\xc9\x27\xe8\x62\x20\x40\x10\x17\xe6\x00\x6a\xaa\x2b\xd8\x73\xa9\xa5\xd1\x63\xff\x30\x6a\x49\x50\x18\x66\x14\xb5\x83\x8e\x1d\xb6\x61\x64\x16\xe8\x83\xfd\x50\x1d\xbc\x2b\x06\xef\xe2\xe0\x85\x39\xcf\x7b\x78\x70\x3b\x1e\xb5\xfc\x01\xf0\x88\x28\x06\x75\xdc\xe9\xb8\xcc\x29\xde\xb5\x44\xc8\x60\x4a\x37\x3f\x8d\xf8\xe2\xec\xe8\x27\x09\x20\xd5\xbc\x08\x21\xa4\x89\xe7\x67\x08\x43\x75\xfb\x43\x22\x99\xdb\x8d\x3a\x5e\x65\xce\x92\xca\x18\x90\x6d\x9c\x20\xdb\x98\x06\x50\x5b\xf4\xc7\x6c\xf9\xd9\x2f\x77\x40\xa6\x32\x1c\x57\x80\xf9\x72\x90\x9f\xd9\xf5\x87\x87\x00\x96\xea\x94\x26\x34\x07\xec\x64\xd4\x23\xaa\x75\xcb\xa1\x1d\x30\x52\x0b\x94\x05\x0d\xc5\x82\x86\x56\x77\xc6\x09\x0d\x94\x6f\x42\xa9\x6d\x60\xb5\xca\x3e\x6c\x9e\xe1\x61\xdc\xaa\x03\xaa\xcc\x0f\x60\xfc\xb2\x52\x24\x2a\x59\x56\x2b\x29\xa5\x58\xd5\xa7\x7f\x29\xb6\x0e\x64\xa8\xe8\xd9\xdf\x46\xb2\x8c\xcf\x20\xe2\x22\x05\x02\x28\x73\xe9\x34\x42\xd2\x02\xda\x69\x32\xf8\x25\x4f\x00\x01\x20\xd5\x8e\x5d\xc7\xd4\x3a\x55\x2c\xae\xba\xb2\x9a\xfa\x2c\x32\x0e\xc5\xb6\x20\x42\x90\x2a\xba\xd1\x61\xd0\xaa\xb7\x3d\x24\xd6\x7e\x4c\xc2\x83\x84\x2a\xbd\xa7\xa3\xf6\x6b\xd8\x31\x0c\xf8\x6c\xa8\x0b\xa2\xa9\xef\x53\x41\xd9\x32\xcb\x70\x6a\x5f\xbb\x51\x7b\x1b\xc1\x28\x1d\xb5\x7f\x42\x98\x2b\x2a\xb7\xe4\x4b\x42\xa9\xa3\x71\x6d\x6d\x2b\x15\x29\x77\xe0\xaa\x50\x91\xc3\x81\xbd\xa3\xb6\x19\xa2\xc1\x03\x85\x39\xfc\xcd\x93\x37\xf1\x47\x10\x78\x86\x80\x50\xcb\xd8\x34\x61\x55\xea\xf3\x18\x1e\x58\xa2\xd1\xdc\x2f\xd8\x06\x7d\xd8\xfe\x79\xa6\x8c\xe2\x8e\xc6\x97\x81\xbc\xdd\xfd\xfd\x67\x82\x31\x4b\x63\x39\x56\x69\x72\x94\x15\x0f\x20\x0e\xc5\x1d\x97\xee\xae\xc0\xa9\xdd\x8e\x4f\x43\x4a\x29\x4c\x1b\x78\xb9\x2f\x78\xb9\x9f\xe2\xe5\x31\xe3\xe5\xb1\xd8\x85\x19\x2b\x2f\x26\x5c\x66\x59\x62\x36\x7c\x82\x47\x63\x27\xc0\x86\xcf\x96\x91\x83\xfc\x1d\xbd\x9c\x85\xa8\x3f\xfa\xfe\x9e\x86\xe9\x43\xa1\xda\x53\x16\x14\x4f\x3d\xbd\x64\xbd\x8b\xb4\xc3\x35\x25\x98\xb1\xf1\xa9\xc4\x7a\xb6\x8c\xe1\x1b\x39\x24\xbb\x75\x0b\xca\xb4\x2c\xb6\xfb\x28\x0d\x7e\x7e\xeb\x41\x8e\x19\x1b\xb6\x1b\x16\xb7\x65\xa1\xc6\x6d\xc1\x45\xf0\xe5\x76\xe3\xd3\xed\x26\xde\xba\xdd\xe0\x8f\x66\xfe\xbe\x13\xb1\x0d\x27\x64\x5b\x44\x56\x0a\xea\xb2\xbd\x24\x22\xd1\x00\x44\x1d\xfa\x9b\x6e\x54\xd8\x29\xee\x2e\xad\xb0\xc0\xe6\x12\xfe\xed\xf2\x20\xdf\xab\xe9\xd6\xe2\xf1\xcd\x26\xe2\x22\x5f\x0c\x60\x8b\x13\xb0\xc5\x3c\x0f\x1e\x5a\xc8\xe3\x01\xd3\x3c\xe9\xaf\xe6\x80\xcc\xe0\x5f\xfa\x40\x12\x4a\x62\x33\x77\x50\x84\xf2\x2a\xb6\xcf\xb7\x26\x07\xbd\xf9\x7c\x0a\xaa\x0a\xa6\x05\x8a\xe9\xfe\x24\x32\xdf\x43\x17\xb0\x23\x89\x43\x98\x39\x54\x13\xfd\xe0\x6f\xda\x55\x15\x8a\xe5\x0e\x36\xa7\xaa\x18\x2f\xc2\x3e\xab\xd0\xe7\x23\x1d\x0d\x15\x2f\x63\x5a\xb1\x71\x00\x02\x26\xe9\x37\x0e\x3c\xf8\xa7\x71\x10\x81\xb4\x49\xc5\xcd\x58\x8a\x9b\x1a\xb8\x1f\xe5\x56\xfe\x64\xba\xb9\xc0\x9c\xaf\x3f\x43\x36\xe9\x86\xff\x45\xaa\xa1\x4a\x01\xac\x25\xa7\x9b\xd8\x9b\xde\x39\x98\x14\xca\x40\x48\x73\x8c\xe1\x92\x60\xc4\x9c\x13\xd2\x7c\xc0\xf4\x50\xfa\x0b\x08\x69\x26\x09\x89\xc6\x7f\x12\x84\x84\x3f\xaa\xfe\x41\x98\x88\x6f\xa1\x4e\x46\x21\x92\x51\x28\xd6\x24\x45\x3f\xb1\x2a\x92\x01\x19\x21\x21\xe1\x59\xb9\xcd\x7a\x24\xfa\xfb\x6a\x00\x78\x06\x93\x83\x37\x4c\x76\x8b\xf3\xc8\x2b\x4d\x63\x71\x61\x1a\x43\x58\x6e\xa1\x32\xc3\x35\x18\x16\x4a\x5b\x07\xb4\x20\xbd\xb6\x97\x00\xd6\x13\xda\x57\xcc\xe3\x01\xf9\x94\x2c\x15\x0d\xcc\x63\x1a\x98\x07\x1a\x58\x44\x77\x95\x80\xee\x2a\x8a\xcc\x1b\x08\xd8\x05\x48\x36\xc0\xf3\x48\x5c\xb1\x03\x32\xaf\xb0\x6e\xe6\xe1\x7d\x99\xb6\x68\x52\x02\x6e\x82\xe6\xe2\x77\xcb\x33\xf6\x32\xfe\x4f\xb4\x28\xcf\xd1\xf5\x63\x6a\xc7\xf0\x77\x0c\x8a\x9b\xdd\xa7\xea\x1b\x2c\xce\x94\xf4\xa7\xd5\xe5\xc1\x8c\x4c\x2b\xf0\x97\xae\x42\x88\xa9\x7f\xdc\x2e\x57\xf0\x68\xf2\xc9\x54\x23\x2a\x6c\x03\xdd\x49\xdd\x76\xfc\x74\xea\x12\x7a\x4d\xfc\x33\x14\x66\x6a\xfc\x5f\xa5\x32\x19\xd4\xae\x8f\x41\xd5\x51\x35\x4a\xa3\x02\x19\x19\x68\x8e\x9d\x5a\x2f\xd4\x53\x6b\xa4\xb9\x8d\xdc\xbc\x36\x94\xe6\x46\xff\x84\xae\x34\xd5\x75\x25\xfc\x2f\xad\x2f\xcd\xe0\xd7\xf2\x20\xc1\x1a\x1f\xcf\x2a\x7d\xeb\x30\xb6\x5a\x0d\x40\xdd\xf1\x01\x66\xac\x19\x83\x4a\x35\xd5\xe9\x73\x0e\xf4\x39\x27\x52\xb5\x9a\x6e\x25\xcf\x34\x8d\x4e\xff\x76\x1a\xdd\xce\x02\xef\x3d\x3f\x0e\x99\x0b\x28\x88\x55\x8c\x11\xc2\x42\xd6\xd9\x3a\xca\x70\x57\x69\xeb\xcf\xbc\xc2\x0c\x3e\x8c\xc4\xa6\xc9\xd3\x11\x6e\x5e\x5c\x2f\x9b\xa3\x1e\x16\x70\x3d\x0c\xab\xa9\xcf\x47\x5c\x2f\x5b\x4a\x1b\xd2\xc8\x9e\x76\xe7\x87\x53\xd4\xcb\x26\xe5\x19\x8a\xc9\x53\xb6\x79\x02\x9e\x74\x46\xdd\x7a\x6b\x44\x86\xf6\x94\xac\x80\x2d\x26\x8b\xb2\xc1\x45\xd9\x58\x87\xc3\x8a\x7f\xe0\x1f\x96\x8f\x0f\x86\x07\x43\x0b\xfd\x05\x1a\xb4\x60\xda\xf6\xec\xf2\x86\xbd\x6b\x1e\x4c\xad\xea\xca\x3a\x9c\xc0\xb2\xa9\x65\x15\x5a\x16\x63\xd8\xe5\xfe\x92\x6c\x0e\xc6\xa4\xef\xc1\x9f\xe8\x60\x0c\x1b\x26\x3d\xf5\x11\xef\xea\xa4\x5f\x27\xf5\xc1\x80\x85\x78\x08\x61\xbc\xea\x50\x46\x38\x94\x91\x75\x38\x45\xfc\x10\x4d\xc6\x07\x23\xd2\x1f\x1f\x94\x47\xd5\xd0\x22\xf8\x6f\x25\xb4\x06\x8a\x09\x08\x38\x45\xfb\x7f\x0e\x0f\xff\x77\x2f\x0e\x97\xd1\xc8\xbd\x72\xe6\x73\x58\xef\x2f\x9f\xdf\xd9\xe2\xaa\xee\x88\xfb\xf5\xd4\xfe\x5f\x0c\x1c\x72\xfe\xff\x03\x00\x00\xff\xff\xdd\x97\x07\xcb\xb7\xe7\x01\x00")
 )
 
-const (
-	rootTemplate = `<!doctype html>
+var rootTemplate = template.Must(template.New("root").Parse(`<!doctype html>
 <html>
 <head>
   <title>checker</title>
 </head>
 <body>
-  <div style="font-family: Courier; width: 100%%">
+  <div style="font-family: Courier; width: 100%">
     <ul>
-      <li><a href="/v1/wifi/status">Wifi Status</a></li>
-      <li><a href="/v1/lan/status">Lan Status</a></li>
-      <li><a href="/v1/wifi">Plot Wifi Graph</a></li>
-      <li><a href="/v1/lan">Plot Lan Graph</a></li>
+      {{range .Series}}<li>{{.}}: <a href="/v1/status?series={{.}}">Status</a>, <a href="/v1/graph?series={{.}}">Plot Graph</a></li>
+      {{else}}<li>No series yet, start an agent.</li>
+      {{end}}
     </ul>
+    <h3>Incidents of the last 7 days</h3>
+    {{if .Incidents}}<table cellpadding="4">
+      <tr><th align="left">Start</th><th align="left">End</th><th align="left">Duration</th><th align="left">Agent</th><th align="left">Series</th><th align="left">First error</th></tr>
+      {{range .Incidents}}<tr valign="top">
+        <td>{{.Start}}</td><td>{{.End}}</td><td>{{.Duration}}</td><td>{{.Agent}}</td>
+        <td>{{range .Series}}<a href="/v1/graph?series={{.}}">{{.}}</a><br>{{end}}</td>
+        <td>{{.FirstError}}</td>
+      </tr>
+      {{end}}
+    </table>{{else}}<p>No incidents.</p>{{end}}
   </div>
 </body>
-</html>`
-	plotsTemplateHead = `<!doctype html>
+</html>`))
+
+// plotsTemplate is filled with the dygraphs source, the URL of the stream
+// that appends to the plot, the window of the plot in seconds, the result of
+// the query to plot and its title.
+const plotsTemplate = `<!doctype html>
 <html>
 <head>
   <title>checker</title>
@@ -36,23 +49,89 @@ const (
   <div id="latencies" style="font-family: Courier; width: 100%%; height: 600px"></div>
   <script>%s</script>
   <script>
-  new Dygraph(
-    document.getElementById("latencies"),"`
-	plotsTemplateTail = `",
-    {
-      title: '%s latency for last 24 hours',
-      labels: ['Seconds', 'Mean', 'Percentile90', 'Percentile99'],
-      ylabel: 'Latency (s)',
-      xlabel: 'Time',
-      showRoller: true,
-      logscale: true,
-      strokeWidth: 1.3
-    }
-  );
+  var names = {
+    mean: 'Mean', p50: 'Percentile50', p90: 'Percentile90', p95: 'Percentile95',
+    p99: 'Percentile99', p999: 'Percentile999', min: 'Min', max: 'Max',
+    count: 'Count', failures: 'Failures'
+  };
+  // keys are the names of the stats in the aggregates the stream sends
+  var keys = {
+    mean: 'mean', p50: 'percentile50', p90: 'percentile90', p95: 'percentile95',
+    p99: 'percentile99', p999: 'percentile999', min: 'min', max: 'max',
+    count: 'count', failures: 'failures'
+  };
+  var streamURL = %s, span = %d * 1000;
+  var element = document.getElementById("latencies");
+  Promise.resolve(%s).then(function(result) {
+    var points = result.series[0].points;
+    // Probe fields the series does not report have no values at all
+    var columns = [];
+    result.stats.forEach(function(stat, i) {
+      if (points.some(function(p) { return p[i + 1] !== null; }) || (points.length == 0 && names[stat])) {
+        columns.push(result.stats[i]);
+      }
+    });
+    var labels = ['Time'].concat(columns.map(function(stat) {
+      if (names[stat]) {
+        return names[stat];
+      }
+      stat = stat.replace(/^field\./, '');
+      return stat.charAt(0).toUpperCase() + stat.slice(1);
+    }));
+    var data = points.map(function(p) {
+      return [new Date(p[0])].concat(columns.map(function(stat) { return p[result.stats.indexOf(stat) + 1]; }));
+    });
+    var graph = null;
+    var plot = function() {
+      if (graph) {
+        graph.updateOptions({file: data});
+        return;
+      }
+      if (data.length == 0) {
+        element.textContent = 'No data in this range yet, waiting for some.';
+        return;
+      }
+      element.textContent = '';
+      graph = new Dygraph(element, data, {
+        title: %s,
+        labels: labels,
+        ylabel: 'Latency (s)',
+        y2label: 'Samples',
+        series: {
+          'Count': {axis: 'y2'},
+          'Failures': {axis: 'y2'}
+        },
+        xlabel: 'Time',
+        showRoller: true,
+        logscale: true,
+        strokeWidth: 1.3
+      });
+    };
+    plot();
+    // Append aggregates as the server flushes them, and drop the points
+    // that fall out of the window
+    new EventSource(streamURL).addEventListener('aggregate', function(e) {
+      var event = JSON.parse(e.data);
+      var row = [new Date(event.time)].concat(columns.map(function(stat) {
+        var v = keys[stat] ? event.status[keys[stat]] : (event.status.fields || {})[stat.replace(/^field\./, '')];
+        return v === undefined ? null : v;
+      }));
+      data.push(row);
+      if (data.length > 1 && data[data.length - 2][0] > row[0]) {
+        data.sort(function(a, b) { return a[0] - b[0]; });
+      }
+      var start = Date.now() - span;
+      while (data.length > 0 && data[0][0] < start) {
+        data.shift();
+      }
+      plot();
+    });
+  }).catch(function(err) {
+    element.textContent = err.message;
+  });
   </script>
 </body>
 </html>`
-)
 
 func asset(bs []byte) []byte {
 	var b bytes.Buffer
@@ -1,18 +1,139 @@
 package common
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexgear/checker/sketch"
+)
+
+// Series identifies the results of one probe run by one agent. Agent,
+// Interface and Probe must not contain slashes, Target may.
+type Series struct {
+	Agent     string `json:"agent"`
+	Interface string `json:"interface"`
+	Probe     string `json:"probe"`
+	Target    string `json:"target"`
+}
+
+// Key returns the string form of the series used by the datastore and the
+// API, "agent/interface/probe/target".
+func (s Series) Key() string {
+	return strings.Join([]string{s.Agent, s.Interface, s.Probe, s.Target}, "/")
+}
+
+// Validate checks that the series can be turned into a key and back.
+func (s Series) Validate() error {
+	if s.Agent == "" || s.Probe == "" || s.Target == "" {
+		return fmt.Errorf("series %q needs an agent, a probe and a target", s.Key())
+	}
+	for _, part := range []string{s.Agent, s.Interface, s.Probe} {
+		if strings.Contains(part, "/") {
+			return fmt.Errorf("series %q has a slash in %q", s.Key(), part)
+		}
+	}
+	return nil
+}
+
+// ParseSeries is the inverse of Series.Key.
+func ParseSeries(key string) (Series, error) {
+	parts := strings.SplitN(key, "/", 4)
+	if len(parts) != 4 {
+		return Series{}, fmt.Errorf("Invalid series %q, expected agent/interface/probe/target", key)
+	}
+	s := Series{Agent: parts[0], Interface: parts[1], Probe: parts[2], Target: parts[3]}
+	return s, s.Validate()
+}
 
 type Response struct {
-	IsUp    bool
-	Latency time.Duration
-	Time    time.Time
+	IsUp    bool               `json:"isUp"`
+	Latency time.Duration      `json:"latency"` // Nanoseconds
+	Time    time.Time          `json:"time"`
+	Error   string             `json:"error,omitempty"`  // error class, empty when the probe succeeded
+	Fields  map[string]float64 `json:"fields,omitempty"` // probe specific measurements
+}
+
+// Result is a Response together with the series it belongs to, as agents
+// submit it to the server.
+type Result struct {
+	Series   Series            `json:"series"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Response Response          `json:"response"`
 }
 
+// HTTPPhases are the fields the http probe reports for each phase of a
+// request, in the order they happen.
+var HTTPPhases = []string{"dns", "connect", "tls", "ttfb", "transfer"}
+
 type Status struct {
+	Count             int     `json:"count"`             // Number of samples
+	Failures          int     `json:"failures"`          // Number of samples that were down
 	Uptime            float64 `json:"uptime"`            // Percents
 	Mean              float64 `json:"mean"`              // Seconds
 	StandardDeviation float64 `json:"standardDeviation"` // Seconds
+	Min               float64 `json:"min"`               // Seconds
+	Max               float64 `json:"max"`               // Seconds
+	Percentile50      float64 `json:"percentile50"`      // Seconds
 	Percentile90      float64 `json:"percentile90"`      // Seconds
 	Percentile95      float64 `json:"percentile95"`      // Seconds
 	Percentile99      float64 `json:"percentile99"`      // Seconds
+	Percentile999     float64 `json:"percentile999"`     // Seconds
+
+	Fields map[string]float64 `json:"fields,omitempty"` // Means of probe specific measurements
+
+	// Sketch holds the latency distribution, so that percentiles of merged
+	// aggregates can be computed. It is nil for aggregates stored before
+	// sketches were introduced.
+	Sketch *sketch.Sketch `json:"sketch,omitempty"`
+
+	// Phases holds the percentiles of the request phases of HTTPPhases,
+	// computed from the sketches in PhaseSketches like those of the latency.
+	Phases        map[string]Percentiles    `json:"phases,omitempty"`
+	PhaseSketches map[string]*sketch.Sketch `json:"phaseSketches,omitempty"`
+}
+
+// Percentiles of a distribution, in seconds.
+type Percentiles struct {
+	Percentile50  float64 `json:"percentile50"`
+	Percentile90  float64 `json:"percentile90"`
+	Percentile95  float64 `json:"percentile95"`
+	Percentile99  float64 `json:"percentile99"`
+	Percentile999 float64 `json:"percentile999"`
+}
+
+// Incident is a time one or more series of an agent were down.
+type Incident struct {
+	ID         uint64    `json:"id"`
+	Agent      string    `json:"agent"`
+	Start      time.Time `json:"start"` // time of the first failure
+	End        time.Time `json:"end"`   // time of the first success after it, zero while ongoing
+	Series     []string  `json:"series"`
+	FirstError string    `json:"firstError"` // error class of the first failure
+	Failures   int       `json:"failures"`
+}
+
+// Ongoing reports whether the series of the incident are still down.
+func (i Incident) Ongoing() bool {
+	return i.End.IsZero()
+}
+
+// Duration returns how long the incident lasted, up to now if it is
+// ongoing.
+func (i Incident) Duration(now time.Time) time.Duration {
+	if i.Ongoing() {
+		return now.Sub(i.Start)
+	}
+	return i.End.Sub(i.Start)
+}
+
+// AlertEvent is a change of the state of an alert, the alert of a rule for
+// a series.
+type AlertEvent struct {
+	ID     uint64    `json:"id"`
+	Rule   string    `json:"rule"`
+	Series string    `json:"series"`
+	State  string    `json:"state"` // "pending", "firing" or "resolved"
+	Time   time.Time `json:"time"`
+	Value  float64   `json:"value"` // value of the rule's metric that caused the change
 }
@@ -2,20 +2,111 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
 
 type config struct {
-	SSID       string // ssid of wifi network
-	Password   string // password of wifi network
-	LanGw      string // lan network gateway
-	WifiGw     string // wifi network gateway
-	LanIef     string // lan interface name
-	WifiIef    string // wifi interface name
-	Server     string // remote server url
-	ListenHost string // server listen host
-	ListenPort int    // server listen port
+	Agent      string  // name the agent reports its results under, defaults to the hostname
+	SSID       string  // ssid of wifi network
+	Password   string  // password of wifi network
+	LanGw      string  // lan network gateway
+	WifiGw     string  // wifi network gateway
+	LanIef     string  // lan interface name
+	WifiIef    string  // wifi interface name
+	Server     string  // remote server url
+	ListenHost string  // server listen host
+	ListenPort int     // server listen port
+	AdminToken string  // bearer token the server's /admin endpoints and /v1/import require, they are disabled if unset
+	Probes     []Probe `toml:"probe"` // probes the agent runs
+	Sinks      []Sink  `toml:"sink"`  // external systems results are forwarded to
+
+	StreamOrigins []string // origins other than the server's own whose pages may open WebSockets on /v1/stream
+
+	BatchSize     int      // results the agent sends at most per request, defaults to 500
+	BatchInterval Duration // time the agent waits for a batch to fill up, defaults to 1s
+	MetricsListen string   // address the agent serves /metrics on, e.g. ":9273", disabled if unset
+
+	Storage         string   // where the server keeps its data, "bolt" or "memory", defaults to bolt
+	DBPath          string   // file the bolt storage keeps its data in, defaults to my.db
+	WALPath         string   // directory the server logs results to until they are stored, defaults to wal
+	StoreRaw        bool     // whether the server keeps individual samples next to the 1s aggregates
+	RawRetention    Duration // how long the server keeps raw samples, defaults to 48h
+	StatusRetention Duration // how long the server keeps 1s aggregates, forever if unset
+
+	SpoolPath       string   // file the agent keeps unsent results in, defaults to spool.db
+	SpoolMaxEntries int      // results kept at most while the server is unreachable, defaults to 1000000
+	SpoolMaxAge     Duration // results older than this are dropped unsent, defaults to 7 days
+
+	Incidents    Incidents // when the server opens and closes incidents
+	Rules        []Rule    `toml:"rule"` // alerts the server evaluates
+	RuleInterval Duration  // time between two evaluations of the rules, defaults to 30s
+	RuleLookback Duration  // time silent series count as down for rules since they last reported, defaults to 24h
+}
+
+// Probe is a single [[probe]] entry of the config.
+type Probe struct {
+	Name      string                 // human readable name, defaults to type and target
+	Type      string                 // probe type, e.g. "tcp", "icmp", "http" or "dns"
+	Target    string                 // probe specific target
+	Interface string                 // interface to probe through, any if empty
+	Interval  Duration               // time between two probes, defaults to 1s
+	Timeout   Duration               // upper bound for a single probe, defaults to 5s
+	Labels    map[string]string      // sent along with every result
+	Options   map[string]interface{} // probe type specific settings
+}
+
+// Sink is a single [[sink]] entry of the config. Both the server and the
+// agent forward the results they get to their sinks.
+type Sink struct {
+	Type          string            // "influx" or "remote_write"
+	URL           string            // endpoint results are posted to
+	Headers       map[string]string // sent with every request, e.g. Authorization
+	BufferSize    int               // results held at most while the sink is slow or down, defaults to 10000
+	BatchSize     int               // results sent at most per request, defaults to 500
+	BatchInterval Duration          // time to wait for a batch to fill up, defaults to 1s
+	Timeout       Duration          // upper bound for a single request, defaults to 10s
+	MaxRetries    int               // times a failed batch is retried before it is dropped, defaults to 5
+}
+
+// Incidents is the [incidents] table of the config. A series is down once
+// Failures of its results within Window failed, and up again after
+// Recoveries consecutive results succeeded.
+type Incidents struct {
+	Failures   int      // failures that open an incident, defaults to 5
+	Window     Duration // time the failures have to happen within, defaults to 30s
+	Recoveries int      // consecutive successes that close an incident, defaults to 3
+	Silence    Duration // time without results after which a series is no longer down, defaults to 10m
+}
+
+// Rule is a single [[rule]] entry of the config. The alert of a series is
+// pending once Aggregation of Metric over the last Window compares to
+// Threshold, and firing once that held for For. It resolves once the value
+// is back beyond Threshold by Hysteresis.
+type Rule struct {
+	Name        string            // unique name of the rule
+	Series      string            // pattern series keys have to match part by part, e.g. "*/wlan0/http/https://*", all if empty
+	Labels      map[string]string // labels series need to have
+	Metric      string            // "latency", "uptime", "failures", "count" or "field.<name>"
+	Aggregation string            // statistic of the latency, e.g. "p99", defaults to mean
+	Window      Duration          // time the metric is computed over, defaults to 5m
+	Comparator  string            // ">", ">=", "<" or "<="
+	Threshold   float64           // in seconds for latencies, in percent for uptime
+	For         Duration          // time the comparison has to hold before the alert fires
+	Hysteresis  float64           // margin beyond Threshold a firing alert needs to resolve
+}
+
+// Duration is a time.Duration that is written as a string like "1m30s" in
+// the config.
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	d.Duration, err = time.ParseDuration(string(text))
+	return err
 }
 
 var C config
@@ -26,5 +117,140 @@ func InitConfig() error {
 	if err != nil {
 		return fmt.Errorf("Failed to decode config: %s", err.Error())
 	}
+	if C.Agent == "" {
+		C.Agent, err = os.Hostname()
+		if err != nil {
+			return fmt.Errorf("Failed to get hostname: %s", err.Error())
+		}
+	}
+	if C.BatchSize <= 0 {
+		C.BatchSize = 500
+	}
+	if C.BatchInterval.Duration <= 0 {
+		C.BatchInterval.Duration = time.Second
+	}
+	if C.Storage == "" {
+		C.Storage = "bolt"
+	}
+	if C.DBPath == "" {
+		C.DBPath = "my.db"
+	}
+	if C.WALPath == "" {
+		C.WALPath = "wal"
+	}
+	if C.RawRetention.Duration <= 0 {
+		C.RawRetention.Duration = 48 * time.Hour
+	}
+	if C.SpoolPath == "" {
+		C.SpoolPath = "spool.db"
+	}
+	if C.SpoolMaxEntries <= 0 {
+		C.SpoolMaxEntries = 1000000
+	}
+	if C.SpoolMaxAge.Duration <= 0 {
+		C.SpoolMaxAge.Duration = 7 * 24 * time.Hour
+	}
+	if C.Incidents.Failures <= 0 {
+		C.Incidents.Failures = 5
+	}
+	if C.Incidents.Window.Duration <= 0 {
+		C.Incidents.Window.Duration = 30 * time.Second
+	}
+	if C.Incidents.Recoveries <= 0 {
+		C.Incidents.Recoveries = 3
+	}
+	if C.Incidents.Silence.Duration <= 0 {
+		C.Incidents.Silence.Duration = 10 * time.Minute
+	}
+	if C.RuleInterval.Duration <= 0 {
+		C.RuleInterval.Duration = 30 * time.Second
+	}
+	if C.RuleLookback.Duration <= 0 {
+		C.RuleLookback.Duration = 24 * time.Hour
+	}
+	names := make(map[string]bool)
+	for i := range C.Rules {
+		r := &C.Rules[i]
+		if r.Name == "" || r.Metric == "" {
+			return fmt.Errorf("Rule %d needs a name and a metric", i+1)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("Rule %q is defined twice", r.Name)
+		}
+		names[r.Name] = true
+		switch r.Comparator {
+		case ">", ">=", "<", "<=":
+		default:
+			return fmt.Errorf("Rule %q has unknown comparator %q", r.Name, r.Comparator)
+		}
+		if r.Window.Duration <= 0 {
+			r.Window.Duration = 5 * time.Minute
+		}
+		if r.Hysteresis < 0 {
+			return fmt.Errorf("Rule %q has a negative hysteresis", r.Name)
+		}
+	}
+	if len(C.Probes) == 0 {
+		C.Probes = legacyProbes()
+	}
+	// Results are stored by type, interface and target, probes that differ
+	// only in name or options would mix their results in one series
+	seen := make(map[[3]string]int)
+	for i := range C.Probes {
+		p := &C.Probes[i]
+		if p.Type == "" || p.Target == "" {
+			return fmt.Errorf("Probe %d needs a type and a target", i+1)
+		}
+		id := [3]string{p.Type, p.Interface, p.Target}
+		if j, ok := seen[id]; ok {
+			return fmt.Errorf("Probes %d and %d both run %s against %s through %q", j+1, i+1, p.Type, p.Target, p.Interface)
+		}
+		seen[id] = i
+		if p.Name == "" {
+			p.Name = fmt.Sprintf("%s %s", p.Type, p.Target)
+		}
+		if p.Interval.Duration <= 0 {
+			p.Interval.Duration = time.Second
+		}
+		if p.Timeout.Duration <= 0 {
+			p.Timeout.Duration = 5 * time.Second
+		}
+	}
+	for i := range C.Sinks {
+		s := &C.Sinks[i]
+		if s.Type == "" || s.URL == "" {
+			return fmt.Errorf("Sink %d needs a type and a url", i+1)
+		}
+		if s.BufferSize <= 0 {
+			s.BufferSize = 10000
+		}
+		if s.BatchSize <= 0 {
+			s.BatchSize = 500
+		}
+		if s.BatchInterval.Duration <= 0 {
+			s.BatchInterval.Duration = time.Second
+		}
+		if s.Timeout.Duration <= 0 {
+			s.Timeout.Duration = 10 * time.Second
+		}
+		if s.MaxRetries <= 0 {
+			s.MaxRetries = 5
+		}
+	}
 	return nil
 }
+
+// legacyProbes returns the probes that were hardcoded in network.Ping, for
+// configs without [[probe]] entries that only set WifiIef and LanIef.
+func legacyProbes() []Probe {
+	var probes []Probe
+	if C.WifiIef != "" {
+		probes = append(probes, Probe{Name: "wifi", Type: "tcp", Target: "8.8.4.4:53", Interface: C.WifiIef,
+			Interval: Duration{200 * time.Millisecond}})
+	}
+	if C.LanIef != "" {
+		probes = append(probes, Probe{Name: "lan", Type: "tcp", Target: "8.8.8.8:53", Interface: C.LanIef,
+			Interval: Duration{200 * time.Millisecond}})
+	}
+	return probes
+}
@@ -0,0 +1,168 @@
+package datastore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// Backuper is implemented by stores that can write a consistent snapshot of
+// themselves while they are in use.
+type Backuper interface {
+	// Backup writes the snapshot to w and returns its size.
+	Backup(w io.Writer) (int64, error)
+}
+
+// Backup writes a snapshot of the db to w, from within a read transaction
+// so writers can go on meanwhile.
+func (s *BoltStore) Backup(w io.Writer) (int64, error) {
+	var n int64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		n, err = tx.WriteTo(w)
+		return err
+	})
+	return n, err
+}
+
+// SeriesReport summarizes what a db holds of a series.
+type SeriesReport struct {
+	Series     string
+	Raw        int // number of raw samples
+	Aggregates int // number of per-second aggregates
+	From, To   time.Time
+}
+
+// Report is the result of Verify.
+type Report struct {
+	Version int
+	Errors  []error // inconsistencies bolt found
+	Series  []SeriesReport
+}
+
+// Verify runs bolt's consistency check on the db at path and summarizes
+// every series in it. The db is opened read-only, but a server that has it
+// open still locks it, verify a snapshot from /admin/backup instead.
+func Verify(path string) (*Report, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second, ReadOnly: true})
+	if err == bolt.ErrTimeout {
+		return nil, fmt.Errorf("Failed to lock db, is the server still running?")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open db: %s", err.Error())
+	}
+	defer db.Close()
+	r := &Report{}
+	err = db.View(func(tx *bolt.Tx) error {
+		for err := range tx.Check() {
+			r.Errors = append(r.Errors, err)
+		}
+		r.Version = readVersion(tx)
+		if tx.Bucket(seriesBucket) == nil {
+			return nil
+		}
+		return forEachSeries(tx, func(key []byte, sb *bolt.Bucket) error {
+			s := SeriesReport{Series: string(key)}
+			for _, sub := range [][]byte{rawBucket, statusBucket} {
+				b := sb.Bucket(sub)
+				if b == nil {
+					continue
+				}
+				n := b.Stats().KeyN
+				if bytes.Equal(sub, rawBucket) {
+					s.Raw = n
+				} else {
+					s.Aggregates = n
+				}
+				c := b.Cursor()
+				if k, _ := c.First(); k != nil {
+					if t, err := keyTime(k); err == nil && (s.From.IsZero() || t.Before(s.From)) {
+						s.From = t
+					}
+				}
+				if k, _ := c.Last(); k != nil {
+					if t, err := keyTime(k); err == nil && t.After(s.To) {
+						s.To = t
+					}
+				}
+			}
+			r.Series = append(r.Series, s)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Restore replaces the db at path with the snapshot after verifying it.
+// The db that is replaced is kept next to it with a .before-restore suffix.
+// Restoring fails while a server has the db open.
+func Restore(snapshot, path string) error {
+	r, err := Verify(snapshot)
+	if err != nil {
+		return fmt.Errorf("Failed to verify snapshot: %s", err.Error())
+	}
+	if len(r.Errors) > 0 {
+		return fmt.Errorf("Snapshot is inconsistent: %s", r.Errors[0].Error())
+	}
+	if r.Version > schemaVersion {
+		return fmt.Errorf("Snapshot has schema version %d, this version of checker only knows up to %d", r.Version, schemaVersion)
+	}
+	// Hold the lock of the current db while it is replaced
+	if _, err = os.Stat(path); err == nil {
+		db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+		if err != nil {
+			return fmt.Errorf("Failed to lock db, is the server still running? %s", err.Error())
+		}
+		defer db.Close()
+	}
+	tmp := path + ".restore"
+	err = copyFile(snapshot, tmp)
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("Failed to copy snapshot: %s", err.Error())
+	}
+	if _, err = os.Stat(path); err == nil {
+		err = os.Rename(path, path+".before-restore")
+		if err != nil {
+			return err
+		}
+		log.Printf("Moved the current db to %s\n", path+".before-restore")
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return err
+	}
+	log.Printf("Restored %d series from %s\n", len(r.Series), snapshot)
+	return nil
+}
+
+func copyFile(from, to string) error {
+	src, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,516 @@
+package datastore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/boltdb/bolt"
+)
+
+// Every series gets its own bucket inside seriesBucket, named by its key.
+// The bucket is created on the first write and holds a status subbucket with
+// the per-second aggregates, a subbucket for each coarser tier, a raw
+// subbucket with the individual samples if they are stored and a labels key
+// with the labels of the agent's probe config. Incidents are kept as JSON in
+// incidentsBucket under their ID as a big-endian uint64, alert events in
+// alertsBucket under their time key followed by their ID, and the latest
+// event of every rule and series once more in alertStateBucket under the
+// rule and the series key, separated by a zero byte. The checkpoint of
+// a series is kept in checkpointsBucket under its key, as its position as a
+// big-endian uint64 followed by the time key of Before.
+var (
+	seriesBucket      = []byte("series")
+	statusBucket      = []byte("status")
+	rawBucket         = []byte("raw")
+	labelsKey         = []byte("labels")
+	incidentsBucket   = []byte("incidents")
+	alertsBucket      = []byte("alerts")
+	alertStateBucket  = []byte("alerts.latest")
+	checkpointsBucket = []byte("checkpoints")
+)
+
+// BoltStore is a Store that keeps its data in a bolt db file.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// OpenBolt opens the db at path, migrating it to the current schema first.
+// Results of dbs written before series had agents are moved to series of
+// config.C.Agent, "checker db migrate -agent" names another one.
+func OpenBolt(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open db: %s", err.Error())
+	}
+	err = migrate(db, path, config.C.Agent, false)
+	if err == nil {
+		err = db.Update(func(tx *bolt.Tx) error {
+			_, err := tx.CreateBucketIfNotExists(seriesBucket)
+			if err != nil {
+				return fmt.Errorf("create bucket: %s", err.Error())
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// bucket returns the bucket of series, creating it if necessary.
+func (s *BoltStore) bucket(tx *bolt.Tx, series string) (*bolt.Bucket, error) {
+	sb, err := tx.Bucket(seriesBucket).CreateBucketIfNotExists([]byte(series))
+	if err != nil {
+		return nil, fmt.Errorf("create series bucket: %s", err.Error())
+	}
+	return sb, nil
+}
+
+func (s *BoltStore) WriteSample(series string, samples ...common.Response) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sb, err := s.bucket(tx, series)
+		if err != nil {
+			return err
+		}
+		return writeSamples(sb, samples)
+	})
+}
+
+func writeSamples(sb *bolt.Bucket, samples []common.Response) error {
+	rb, err := sb.CreateBucketIfNotExists(rawBucket)
+	if err != nil {
+		return fmt.Errorf("create raw bucket: %s", err.Error())
+	}
+	for _, r := range samples {
+		err = rb.Put(timeKey(r.Time), encodeSample(r))
+		if err != nil {
+			return fmt.Errorf("update bucket: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func (s *BoltStore) WriteAggregate(series string, status map[time.Time]common.Status) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sb, err := s.bucket(tx, series)
+		if err != nil {
+			return err
+		}
+		return writeAggregates(sb, status)
+	})
+}
+
+func writeAggregates(sb *bolt.Bucket, status map[time.Time]common.Status) error {
+	b, err := sb.CreateBucketIfNotExists(statusBucket)
+	if err != nil {
+		return fmt.Errorf("create status bucket: %s", err.Error())
+	}
+	for t, st := range status {
+		err = mergeStatus(b, t, st)
+		if err != nil {
+			return err
+		}
+	}
+	return rollup(sb, status)
+}
+
+func (s *BoltStore) WriteFlush(series string, samples []common.Response, status map[time.Time]common.Status, c Checkpoint) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sb, err := s.bucket(tx, series)
+		if err != nil {
+			return err
+		}
+		if len(samples) > 0 {
+			err = writeSamples(sb, samples)
+			if err != nil {
+				return err
+			}
+		}
+		err = writeAggregates(sb, status)
+		if err != nil {
+			return err
+		}
+		cb, err := tx.CreateBucketIfNotExists(checkpointsBucket)
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err.Error())
+		}
+		v := make([]byte, 8, 16)
+		binary.BigEndian.PutUint64(v, c.Position)
+		v = append(v, timeKey(c.Before)...)
+		err = cb.Put([]byte(series), v)
+		if err != nil {
+			return fmt.Errorf("update bucket: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) Checkpoints() (map[string]Checkpoint, error) {
+	checkpoints := make(map[string]Checkpoint)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(checkpointsBucket)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			if len(v) != 16 {
+				return fmt.Errorf("Invalid checkpoint of %s", k)
+			}
+			before, err := keyTime(v[8:])
+			if err != nil {
+				return err
+			}
+			checkpoints[string(k)] = Checkpoint{binary.BigEndian.Uint64(v), before}
+			return nil
+		})
+	})
+	return checkpoints, err
+}
+
+func (s *BoltStore) PutAggregates(series string, resolution time.Duration, status map[time.Time]common.Status) error {
+	tier, err := tierOf(resolution)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sb, err := s.bucket(tx, series)
+		if err != nil {
+			return err
+		}
+		b, err := sb.CreateBucketIfNotExists(tier.bucket)
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err.Error())
+		}
+		for t, st := range status {
+			v, err := encodeStatus(st)
+			if err != nil {
+				return fmt.Errorf("Failed to encode aggregate: %s", err.Error())
+			}
+			err = b.Put(timeKey(t), v)
+			if err != nil {
+				return fmt.Errorf("update bucket: %s", err.Error())
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) SetLabels(series string, l map[string]string) error {
+	lEncoded, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("Failed to encode to json: %s", err.Error())
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sb, err := s.bucket(tx, series)
+		if err != nil {
+			return err
+		}
+		err = sb.Put(labelsKey, lEncoded)
+		if err != nil {
+			return fmt.Errorf("update bucket: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) Labels(series string) (map[string]string, error) {
+	var l map[string]string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		sb := tx.Bucket(seriesBucket).Bucket([]byte(series))
+		if sb == nil {
+			return ErrUnknownSeries
+		}
+		if v := sb.Get(labelsKey); v != nil {
+			return json.Unmarshal(v, &l)
+		}
+		return nil
+	})
+	if err == ErrUnknownSeries {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to query db: %s", err.Error())
+	}
+	return l, nil
+}
+
+func (s *BoltStore) ReadRaw(series string, from, to time.Time) ([]common.Response, error) {
+	var samples []common.Response
+	err := s.db.View(func(tx *bolt.Tx) error {
+		sb := tx.Bucket(seriesBucket).Bucket([]byte(series))
+		if sb == nil {
+			return ErrUnknownSeries
+		}
+		rb := sb.Bucket(rawBucket)
+		if rb == nil {
+			return nil
+		}
+		c := rb.Cursor()
+		max := timeKey(to)
+		for k, v := c.Seek(timeKey(from)); k != nil && bytes.Compare(k, max) <= 0; k, v = c.Next() {
+			t, err := keyTime(k)
+			if err != nil {
+				return err
+			}
+			r, err := decodeSample(t, v)
+			if err != nil {
+				return fmt.Errorf("Failed to decode sample at %s: %s", t, err.Error())
+			}
+			samples = append(samples, r)
+		}
+		return nil
+	})
+	if err == ErrUnknownSeries {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to query db: %s", err.Error())
+	}
+	return samples, nil
+}
+
+func (s *BoltStore) ListSeries() ([]string, error) {
+	var series []string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(seriesBucket).ForEach(func(k, v []byte) error {
+			// Buckets have nil values
+			if v == nil {
+				series = append(series, string(k))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to query db: %s", err.Error())
+	}
+	return series, nil
+}
+
+func (s *BoltStore) Query(series string, from, to time.Time, step time.Duration) (map[time.Time]common.Status, error) {
+	tier := tierFor(step)
+	min := timeKey(from.Truncate(tier.resolution))
+	max := timeKey(to)
+	status := make(map[time.Time]common.Status)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		sb := tx.Bucket(seriesBucket).Bucket([]byte(series))
+		if sb == nil {
+			return ErrUnknownSeries
+		}
+		b := sb.Bucket(tier.bucket)
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.Seek(min); k != nil && bytes.Compare(k, max) <= 0; k, v = c.Next() {
+			t, err := keyTime(k)
+			if err != nil {
+				return err
+			}
+			st, err := decodeStatus(v)
+			if err != nil {
+				return fmt.Errorf("Failed to decode aggregate at %s: %s", t, err.Error())
+			}
+			status[t] = st
+		}
+		return nil
+	})
+	if err == ErrUnknownSeries {
+		return status, err
+	}
+	if err != nil {
+		return status, fmt.Errorf("Failed to query db: %s", err.Error())
+	}
+	return status, nil
+}
+
+func (s *BoltStore) DeleteBefore(raw, status time.Time) (int, int, error) {
+	var samples, aggregates int
+	var err error
+	if !raw.IsZero() {
+		samples, err = s.deleteBefore(rawBucket, timeKey(raw))
+		if err != nil {
+			return samples, 0, err
+		}
+	}
+	if !status.IsZero() {
+		aggregates, err = s.deleteBefore(statusBucket, timeKey(status))
+	}
+	return samples, aggregates, err
+}
+
+// deleteBefore deletes the keys lower than min from the sub bucket of every
+// series and returns how many it deleted.
+func (s *BoltStore) deleteBefore(sub []byte, min []byte) (int, error) {
+	series, err := s.ListSeries()
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, key := range series {
+		for {
+			n := 0
+			err = s.db.Update(func(tx *bolt.Tx) error {
+				sb := tx.Bucket(seriesBucket).Bucket([]byte(key))
+				if sb == nil || sb.Bucket(sub) == nil {
+					return nil
+				}
+				b := sb.Bucket(sub)
+				var expired [][]byte
+				c := b.Cursor()
+				for k, _ := c.First(); k != nil && bytes.Compare(k, min) < 0 && len(expired) < expireBatch; k, _ = c.Next() {
+					expired = append(expired, append([]byte(nil), k...))
+				}
+				for _, k := range expired {
+					if err := b.Delete(k); err != nil {
+						return err
+					}
+				}
+				n = len(expired)
+				return nil
+			})
+			if err != nil {
+				return total, err
+			}
+			total += n
+			if n < expireBatch {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+func (s *BoltStore) PutIncident(i *common.Incident) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(incidentsBucket)
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err.Error())
+		}
+		if i.ID == 0 {
+			i.ID, err = b.NextSequence()
+			if err != nil {
+				return err
+			}
+		}
+		v, err := json.Marshal(i)
+		if err != nil {
+			return fmt.Errorf("Failed to encode to json: %s", err.Error())
+		}
+		k := make([]byte, 8)
+		binary.BigEndian.PutUint64(k, i.ID)
+		return b.Put(k, v)
+	})
+}
+
+// Incidents reads all incidents and keeps those in range, there are few
+// of them.
+func (s *BoltStore) Incidents(from, to time.Time) ([]common.Incident, error) {
+	var incidents []common.Incident
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(incidentsBucket)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var i common.Incident
+			err := json.Unmarshal(v, &i)
+			if err != nil {
+				return fmt.Errorf("Failed to decode incident: %s", err.Error())
+			}
+			if overlaps(i, from, to) {
+				incidents = append(incidents, i)
+			}
+			return nil
+		})
+	})
+	sortIncidents(incidents)
+	return incidents, err
+}
+
+func (s *BoltStore) AddAlertEvent(e *common.AlertEvent) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(alertsBucket)
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err.Error())
+		}
+		e.ID, err = b.NextSequence()
+		if err != nil {
+			return err
+		}
+		v, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("Failed to encode to json: %s", err.Error())
+		}
+		err = b.Put(binary.BigEndian.AppendUint64(timeKey(e.Time), e.ID), v)
+		if err != nil {
+			return err
+		}
+		return putAlertState(tx, e, v)
+	})
+}
+
+// putAlertState makes the event e, encoded as v, the latest of its rule and
+// series.
+func putAlertState(tx *bolt.Tx, e *common.AlertEvent, v []byte) error {
+	b, err := tx.CreateBucketIfNotExists(alertStateBucket)
+	if err != nil {
+		return fmt.Errorf("create bucket: %s", err.Error())
+	}
+	return b.Put([]byte(e.Rule+"\x00"+e.Series), v)
+}
+
+func (s *BoltStore) LatestAlertEvents() ([]common.AlertEvent, error) {
+	var events []common.AlertEvent
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(alertStateBucket)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var e common.AlertEvent
+			err := json.Unmarshal(v, &e)
+			if err != nil {
+				return fmt.Errorf("Failed to decode alert event: %s", err.Error())
+			}
+			events = append(events, e)
+			return nil
+		})
+	})
+	return events, err
+}
+
+func (s *BoltStore) AlertEvents(from, to time.Time) ([]common.AlertEvent, error) {
+	var events []common.AlertEvent
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(alertsBucket)
+		if b == nil {
+			return nil
+		}
+		max := timeKey(to)
+		c := b.Cursor()
+		for k, v := c.Seek(timeKey(from)); k != nil && bytes.Compare(k[:8], max) <= 0; k, v = c.Next() {
+			var e common.AlertEvent
+			err := json.Unmarshal(v, &e)
+			if err != nil {
+				return fmt.Errorf("Failed to decode alert event: %s", err.Error())
+			}
+			events = append(events, e)
+		}
+		return nil
+	})
+	return events, err
+}
@@ -0,0 +1,394 @@
+package datastore
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/sketch"
+	"github.com/montanaflynn/stats"
+)
+
+// shards is the number of independently locked parts of a Cache.
+const shards = 16
+
+// Cache collects incoming responses per series and second until Flush
+// aggregates them into a Store. Series are spread over shards, so that
+// writes to different series rarely wait for each other or for a Flush.
+// Every response is appended to a write-ahead log first and replayed from
+// it after a restart. Labels are not logged, agents send them with every
+// result anyway.
+type Cache struct {
+	store  Store
+	shards [shards]shard
+
+	walMu sync.Mutex
+	wal   *wal // nil if responses are not logged
+
+	// flushMu keeps flushes from truncating the log under each other
+	flushMu sync.Mutex
+
+	onFlush func(series string, status map[time.Time]common.Status)
+}
+
+type shard struct {
+	mu        sync.Mutex
+	responses map[string]map[time.Time][]common.Response
+	// labels holds the latest labels of each series until they are flushed
+	labels map[string]map[string]string
+}
+
+// NewCache returns a cache in front of store that logs to the directory
+// walPath, after replaying what is logged there and was not flushed yet.
+// With an empty walPath nothing is logged, e.g. for imports whose results
+// are in files anyway.
+func NewCache(store Store, walPath string) (*Cache, error) {
+	c := &Cache{store: store}
+	for i := range c.shards {
+		c.shards[i].responses = make(map[string]map[time.Time][]common.Response)
+		c.shards[i].labels = make(map[string]map[string]string)
+	}
+	if walPath == "" {
+		return c, nil
+	}
+	checkpoints, err := store.Checkpoints()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read checkpoints: %s", err.Error())
+	}
+	var minSeq uint64
+	for _, cp := range checkpoints {
+		if seq := cp.Position >> 32; seq > minSeq {
+			minSeq = seq
+		}
+	}
+	l, entries, err := openWAL(walPath, minSeq)
+	if err != nil {
+		return nil, err
+	}
+	c.wal = l
+	replayed := 0
+	for _, e := range entries {
+		// Flushed before the server went down, but not truncated
+		cp, ok := checkpoints[e.series]
+		if ok && e.pos <= cp.Position && walSecond(e.response).Before(cp.Before) {
+			continue
+		}
+		c.shardOf(e.series).add(e.series, e.response)
+		replayed++
+	}
+	if replayed > 0 {
+		log.Printf("Replayed %d responses from the wal\n", replayed)
+	}
+	return c, nil
+}
+
+func (c *Cache) shardOf(series string) *shard {
+	h := fnv.New32a()
+	h.Write([]byte(series))
+	return &c.shards[h.Sum32()%shards]
+}
+
+func (s *shard) add(series string, r common.Response) {
+	if s.responses[series] == nil {
+		s.responses[series] = make(map[time.Time][]common.Response)
+	}
+	t := walSecond(r)
+	s.responses[series][t] = append(s.responses[series][t], r)
+}
+
+// Write caches a response of series until Flush aggregates it. It is only
+// durable once Sync returned.
+func (c *Cache) Write(series string, r common.Response) error {
+	s := c.shardOf(series)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c.wal != nil {
+		c.walMu.Lock()
+		err := c.wal.append(series, r)
+		c.walMu.Unlock()
+		if err != nil {
+			return fmt.Errorf("Failed to append to wal: %s", err.Error())
+		}
+	}
+	s.add(series, r)
+	return nil
+}
+
+// OnFlush makes Flush call fn with the aggregates of every series it wrote
+// to the store. It must be called before the cache is used.
+func (c *Cache) OnFlush(fn func(series string, status map[time.Time]common.Status)) {
+	c.onFlush = fn
+}
+
+// Sync makes all responses written so far durable.
+func (c *Cache) Sync() error {
+	if c.wal == nil {
+		return nil
+	}
+	c.walMu.Lock()
+	defer c.walMu.Unlock()
+	return c.wal.sync()
+}
+
+// SetLabels replaces the labels of series on the next Flush.
+func (c *Cache) SetLabels(series string, l map[string]string) {
+	s := c.shardOf(series)
+	s.mu.Lock()
+	s.labels[series] = l
+	s.mu.Unlock()
+}
+
+// Close waits for a running Flush, then syncs and closes the write-ahead
+// log. Responses that were not flushed are replayed by the next NewCache.
+func (c *Cache) Close() error {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+	if c.wal == nil {
+		return nil
+	}
+	c.walMu.Lock()
+	defer c.walMu.Unlock()
+	return c.wal.close()
+}
+
+// flushed is what a Flush takes from a shard to write to the store.
+type flushed struct {
+	labels     map[string]map[string]string
+	status     map[string]map[time.Time]common.Status
+	responses  map[string][]common.Response
+	checkpoint Checkpoint
+}
+
+// take removes the labels and the responses of every second before cutoff
+// from the shard s and aggregates them. Responses that can't be aggregated
+// are dropped.
+func (c *Cache) take(s *shard, cutoff time.Time) flushed {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := flushed{
+		labels:     s.labels,
+		status:     make(map[string]map[time.Time]common.Status),
+		responses:  make(map[string][]common.Response),
+		checkpoint: Checkpoint{Before: cutoff},
+	}
+	// Writes hold the lock of their shard while they log, so every
+	// response of the shard up to here is cached or flushed already
+	if c.wal != nil {
+		c.walMu.Lock()
+		f.checkpoint.Position = c.wal.position()
+		c.walMu.Unlock()
+	}
+	s.labels = make(map[string]map[string]string)
+	for series, responses := range s.responses {
+		status, err := average(responses, cutoff)
+		if err != nil {
+			// Retrying won't fix them, and keeping them would hold up the
+			// truncation of the log
+			log.Printf("Dropping cached responses of %s: Failed to calculate averages: %s\n", series, err.Error())
+		} else if len(status) == 0 {
+			continue
+		}
+		for t, r := range responses {
+			if t.Before(cutoff) {
+				if err == nil {
+					f.responses[series] = append(f.responses[series], r...)
+				}
+				delete(responses, t)
+			}
+		}
+		if err == nil {
+			f.status[series] = status
+		}
+		if len(responses) == 0 {
+			delete(s.responses, series)
+		}
+	}
+	return f
+}
+
+// Flush writes the aggregates of every second that is older than 5 seconds
+// to the store, along with the raw samples if config.C.StoreRaw is set, and
+// then truncates the write-ahead log. Seconds that were flushed before,
+// e.g. because an agent replayed results late, are merged with what is
+// stored already. What could not be written stays cached for the next
+// Flush.
+//
+// Every series is written together with a checkpoint of how far the log was
+// flushed, so that should the server go down before the log is truncated,
+// the flushed responses are not replayed and counted twice.
+func (c *Cache) Flush() error {
+	return c.FlushBefore(time.Now().Add(-5 * time.Second))
+}
+
+// FlushBefore is Flush for every second before cutoff.
+func (c *Cache) FlushBefore(cutoff time.Time) error {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+	if c.wal != nil {
+		c.walMu.Lock()
+		err := c.wal.rotate()
+		c.walMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	for i := range c.shards {
+		f := c.take(&c.shards[i], cutoff)
+		err := c.write(f)
+		if err != nil {
+			c.shards[i].restore(f)
+			return err
+		}
+	}
+	if c.wal == nil {
+		return nil
+	}
+	// Every response of the sealed segments before cutoff was flushed
+	c.walMu.Lock()
+	defer c.walMu.Unlock()
+	return c.wal.truncate(cutoff)
+}
+
+// write writes what was taken from a shard to the store, removing what it
+// wrote from f.
+func (c *Cache) write(f flushed) error {
+	for series, l := range f.labels {
+		err := c.store.SetLabels(series, l)
+		if err != nil {
+			return fmt.Errorf("Failed to write labels: %s", err.Error())
+		}
+		delete(f.labels, series)
+	}
+	for series, status := range f.status {
+		var samples []common.Response
+		if config.C.StoreRaw {
+			samples = f.responses[series]
+		}
+		var err error
+		if c.wal != nil {
+			err = c.store.WriteFlush(series, samples, status, f.checkpoint)
+		} else {
+			err = c.store.WriteSample(series, samples...)
+			if err == nil {
+				err = c.store.WriteAggregate(series, status)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to write aggregates: %s", err.Error())
+		}
+		delete(f.status, series)
+		delete(f.responses, series)
+		if c.onFlush != nil {
+			c.onFlush(series, status)
+		}
+	}
+	return nil
+}
+
+// restore puts back what a failed Flush took from the shard and did not
+// write. Labels set since are newer and kept.
+func (s *shard) restore(f flushed) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for series, l := range f.labels {
+		if _, ok := s.labels[series]; !ok {
+			s.labels[series] = l
+		}
+	}
+	for series := range f.status {
+		for _, r := range f.responses[series] {
+			s.add(series, r)
+		}
+	}
+}
+
+// average aggregates the cached responses of every second before cutoff.
+func average(c map[time.Time][]common.Response, cutoff time.Time) (map[time.Time]common.Status, error) {
+	var err error
+	result := make(map[time.Time]common.Status)
+	for t, responses := range c {
+		if t.Before(cutoff) {
+			var s common.Status
+			s.Count = len(responses)
+			s.Sketch = sketch.New()
+			var latency []float64
+			var isUps []bool
+			fields := make(map[string][]float64)
+			for _, r := range responses {
+				s.Sketch.Add(r.Latency.Seconds())
+				latency = append(latency, r.Latency.Seconds())
+				isUps = append(isUps, r.IsUp)
+				for name, value := range r.Fields {
+					fields[name] = append(fields[name], value)
+				}
+				for _, phase := range common.HTTPPhases {
+					value, ok := r.Fields[phase]
+					if !ok {
+						continue
+					}
+					if s.PhaseSketches == nil {
+						s.PhaseSketches = make(map[string]*sketch.Sketch)
+					}
+					if s.PhaseSketches[phase] == nil {
+						s.PhaseSketches[phase] = sketch.New()
+					}
+					s.PhaseSketches[phase].Add(value)
+				}
+			}
+			s.Mean, err = stats.Mean(latency)
+			if err != nil {
+				return result, fmt.Errorf("Failed to calculate mean: %s", err.Error())
+			}
+			s.StandardDeviation, err = stats.StandardDeviationPopulation(latency)
+			if err != nil {
+				return result, fmt.Errorf("Failed to calculate standard deviation: %s", err.Error())
+			}
+			s.Min, err = stats.Min(latency)
+			if err != nil {
+				return result, fmt.Errorf("Failed to calculate min: %s", err.Error())
+			}
+			s.Max, err = stats.Max(latency)
+			if err != nil {
+				return result, fmt.Errorf("Failed to calculate max: %s", err.Error())
+			}
+			for _, p := range []struct {
+				percent float64
+				value   *float64
+			}{
+				{50.0, &s.Percentile50},
+				{90.0, &s.Percentile90},
+				{95.0, &s.Percentile95},
+				{99.0, &s.Percentile99},
+				{99.9, &s.Percentile999},
+			} {
+				*p.value, err = stats.Percentile(latency, p.percent)
+				if err != nil {
+					return result, fmt.Errorf("Failed to calculate %vth percentile: %s", p.percent, err.Error())
+				}
+			}
+			var uptimeUp int
+			for _, up := range isUps {
+				if up {
+					uptimeUp += 1
+				}
+			}
+			s.Failures = len(isUps) - uptimeUp
+			s.Uptime = float64(uptimeUp) * 100 / float64(len(isUps))
+			for name, values := range fields {
+				if s.Fields == nil {
+					s.Fields = make(map[string]float64)
+				}
+				s.Fields[name], err = stats.Mean(values)
+				if err != nil {
+					return result, fmt.Errorf("Failed to calculate mean of %s: %s", name, err.Error())
+				}
+			}
+			result[t] = s
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,143 @@
+package datastore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+const cacheSeries = "agent/eth0/icmp/192.0.2.1"
+
+func writeResponses(t *testing.T, c *Cache, start time.Time, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		r := common.Response{IsUp: true, Latency: time.Millisecond, Time: start.Add(time.Duration(i) * time.Second)}
+		if err := c.Write(cacheSeries, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.Sync(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func storedCount(t *testing.T, store Store, from time.Time) int {
+	t.Helper()
+	status, err := store.Query(cacheSeries, from, from.Add(time.Hour), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for _, s := range status {
+		count += s.Count
+	}
+	return count
+}
+
+// copyDir copies the files of a directory, to bring back wal segments that
+// a Flush removed.
+func copyDir(t *testing.T, from, to string) {
+	t.Helper()
+	names, err := filepath.Glob(filepath.Join(from, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(to, 0700)
+	for _, name := range names {
+		if err := copyFile(name, filepath.Join(to, filepath.Base(name))); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCacheReplaysUnflushed(t *testing.T) {
+	dir := t.TempDir()
+	store := NewMemory()
+	c, err := NewCache(store, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().UTC().Truncate(time.Second).Add(-time.Minute)
+	writeResponses(t, c, start, 3)
+	c.Close()
+
+	c, err = NewCache(store, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := storedCount(t, store, start); n != 3 {
+		t.Errorf("Expected the 3 logged responses to be stored, got %d", n)
+	}
+	c.Close()
+}
+
+func TestCacheSkipsFlushedAfterCrash(t *testing.T) {
+	dir := t.TempDir()
+	store := NewMemory()
+	c, err := NewCache(store, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().UTC().Truncate(time.Second).Add(-time.Minute)
+	writeResponses(t, c, start, 3)
+	// Keep the segments the flush truncates, as if the server went down
+	// between writing to the store and truncating the log
+	crashed := filepath.Join(t.TempDir(), "wal")
+	copyDir(t, dir, crashed)
+	if err = c.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+
+	c, err = NewCache(store, crashed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A late response of a flushed second is logged after the checkpoint
+	// and must be replayed
+	writeResponses(t, c, start, 1)
+	c.Close()
+	c, err = NewCache(store, crashed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := storedCount(t, store, start); n != 4 {
+		t.Errorf("Expected 4 responses to be stored once, got %d", n)
+	}
+	c.Close()
+}
+
+func TestCacheTruncatesFlushedSegments(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewCache(NewMemory(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	now := time.Now().UTC()
+	writeResponses(t, c, now.Add(-time.Minute), 2)
+	writeResponses(t, c, now.Add(time.Minute), 1)
+	for i := 0; i < 3; i++ {
+		if err = c.Flush(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	segments, err := walSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The one holding the response that is not flushed yet, and the one
+	// written to
+	if len(segments) != 2 {
+		t.Errorf("Expected 2 wal segments, got %v", segments)
+	}
+}
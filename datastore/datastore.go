@@ -1,146 +1,98 @@
 package datastore
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/alexgear/checker/common"
-	"github.com/boltdb/bolt"
-	"github.com/montanaflynn/stats"
 )
 
-var err error
-var db *bolt.DB
+// ErrUnknownSeries is returned when reading a series nothing was written to.
+var ErrUnknownSeries = errors.New("unknown series")
 
-func InitDB() (*bolt.DB, error) {
-	db, err = bolt.Open("my.db", 0600, &bolt.Options{Timeout: 5 * time.Second})
-	if err != nil {
-		return nil, fmt.Errorf(err.Error())
-	}
-	buckets := []string{"wifi", "lan"}
-	subbuckets := []string{"latency", "status"}
-	err = db.Update(func(tx *bolt.Tx) error {
-		for _, bucket := range buckets {
-			b, err := tx.CreateBucketIfNotExists([]byte(bucket))
-			if err != nil {
-				return fmt.Errorf("create bucket: %s", err.Error())
-			}
-			for _, subbucket := range subbuckets {
-				_, err = b.CreateBucketIfNotExists([]byte(subbucket))
-				if err != nil {
-					return fmt.Errorf("create subbucket: %s", err.Error())
-				}
-
-			}
-		}
-		return nil
-	})
-	return db, err
+// Store keeps the raw samples, the aggregates and the labels of every
+// series. Aggregates are kept in every tier, implementations roll up what is
+// written to the per-second tier themselves.
+type Store interface {
+	// WriteSample stores raw samples of series.
+	WriteSample(series string, samples ...common.Response) error
+	// WriteAggregate merges per-second aggregates of series with what is
+	// stored already and rolls them up into the coarser tiers.
+	WriteAggregate(series string, status map[time.Time]common.Status) error
+	// WriteFlush does what WriteSample and WriteAggregate do and records c
+	// as the checkpoint of series, all or nothing of it.
+	WriteFlush(series string, samples []common.Response, status map[time.Time]common.Status, c Checkpoint) error
+	// Checkpoints returns the latest checkpoint of every series WriteFlush
+	// wrote.
+	Checkpoints() (map[string]Checkpoint, error)
+	// PutAggregates replaces aggregates of series in the tier of the given
+	// resolution, and rolls nothing up.
+	PutAggregates(series string, resolution time.Duration, status map[time.Time]common.Status) error
+	// SetLabels replaces the labels of series.
+	SetLabels(series string, labels map[string]string) error
+	// Labels returns the labels of series.
+	Labels(series string) (map[string]string, error)
+	// Query returns the aggregates of series between from and to from the
+	// coarsest tier whose resolution is at most step.
+	Query(series string, from, to time.Time, step time.Duration) (map[time.Time]common.Status, error)
+	// ReadRaw returns the raw samples of series between from and to, oldest
+	// first.
+	ReadRaw(series string, from, to time.Time) ([]common.Response, error)
+	// ListSeries returns the keys of all series, sorted.
+	ListSeries() ([]string, error)
+	// DeleteBefore deletes the raw samples before raw and the per-second
+	// aggregates before status of every series, and returns how many of
+	// each it deleted. A zero time deletes nothing.
+	DeleteBefore(raw, status time.Time) (int, int, error)
+	// PutIncident stores an incident, replacing the one with the same ID.
+	// An incident without an ID gets the next free one.
+	PutIncident(i *common.Incident) error
+	// Incidents returns the incidents that overlap from..to, oldest first.
+	// Ongoing incidents overlap everything after their start.
+	Incidents(from, to time.Time) ([]common.Incident, error)
+	// AddAlertEvent appends an event to the alert history and sets its ID.
+	AddAlertEvent(e *common.AlertEvent) error
+	// AlertEvents returns the alert history from..to, oldest first.
+	AlertEvents(from, to time.Time) ([]common.AlertEvent, error)
+	// LatestAlertEvents returns the latest event of every rule and series
+	// in the alert history.
+	LatestAlertEvents() ([]common.AlertEvent, error)
+	Close() error
 }
 
-var cache = make(map[string]map[time.Time][]common.Response)
-
-func average(c map[time.Time][]common.Response) (map[time.Time]common.Status, error) {
-	result := make(map[time.Time]common.Status)
-	for t, responses := range c {
-		if time.Since(t).Seconds() > 5.0 {
-			var s common.Status
-			var latency []float64
-			var isUps []bool
-			for _, r := range responses {
-				latency = append(latency, r.Latency.Seconds())
-				isUps = append(isUps, r.IsUp)
-			}
-			s.Mean, err = stats.Mean(latency)
-			if err != nil {
-				return result, fmt.Errorf("Failed to calculate mean: %s", err.Error())
-			}
-			s.Percentile90, err = stats.Percentile(latency, 90.0)
-			if err != nil {
-				return result, fmt.Errorf("Failed to calculate 90th percentile: %s", err.Error())
-			}
-			s.Percentile99, err = stats.Percentile(latency, 99.0)
-			if err != nil {
-				return result, fmt.Errorf("Failed to calculate 99th percentile: %s", err.Error())
-			}
-			var uptimeUp int
-			for _, up := range isUps {
-				if up {
-					uptimeUp += 1
-				}
-			}
-			s.Uptime = float64(uptimeUp) * 100 / float64(len(isUps))
-			result[t] = s
-		}
-	}
-	return result, nil
+// Checkpoint tells how far a Cache flushed a series: every response of the
+// series logged up to Position in its write-ahead log, of a second before
+// Before, is in the store.
+type Checkpoint struct {
+	Position uint64
+	Before   time.Time
 }
 
-func FlushCache() error {
-	start := time.Now()
-	for ief, c := range cache {
-		status, err := average(c)
-		if err != nil {
-			return fmt.Errorf("Failed to caculate averages of cached data: %s", err.Error())
-		}
-		// Invalidate cache
-		for t, _ := range c {
-			if start.Sub(t).Seconds() > 5.0 {
-				delete(cache[ief], t)
-			}
-		}
-		// Write to db
-		err = db.Update(func(tx *bolt.Tx) error {
-			for t, s := range status {
-				b := tx.Bucket([]byte(ief)).Bucket([]byte("status"))
-				sEncoded, err := json.Marshal(s)
-				if err != nil {
-					return fmt.Errorf("Failed to encode to json: %s", err.Error())
-				}
-				err = b.Put([]byte(t.UTC().Format(time.RFC3339)), sEncoded)
-				if err != nil {
-					return fmt.Errorf("update bucket: %s", err.Error())
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			fmt.Errorf("Failed to write to db: %s", err.Error())
-		}
+// Open opens the store of the given backend, "bolt" or "memory". The bolt
+// backend keeps its data in the file at path.
+func Open(backend, path string) (Store, error) {
+	switch backend {
+	case "bolt":
+		return OpenBolt(path)
+	case "memory":
+		return NewMemory(), nil
 	}
+	return nil, fmt.Errorf("Unknown storage backend %q", backend)
 }
 
-func Write(ief string, r common.Response) error {
-	if cache[ief] == nil {
-		cache[ief] = make(map[time.Time][]common.Response)
-	}
-	cache[ief][r.Time.Round(time.Second)] = append(cache[ief][r.Time.Round(time.Second)], r)
-	return nil
+// overlaps reports whether the incident overlaps from..to.
+func overlaps(i common.Incident, from, to time.Time) bool {
+	return !i.Start.After(to) && (i.Ongoing() || !i.End.Before(from))
 }
 
-func Read(ief string, timeDelta time.Duration) (map[time.Time]common.Status, error) {
-	min := time.Now().UTC().Add(-1 * timeDelta)
-	status := make(map[time.Time]common.Status)
-	err = db.View(func(tx *bolt.Tx) error {
-		c := tx.Bucket([]byte(ief)).Bucket([]byte("status")).Cursor()
-		for k, v := c.Seek([]byte(min.Format(time.RFC3339))); k != nil; k, v = c.Next() {
-			var s common.Status
-			err = json.Unmarshal(v, &s)
-			if err != nil {
-				return fmt.Errorf("Failed to decode bytes: %s", err.Error())
-			}
-			//fmt.Printf("time=%s, latency=%s, uptime=%s\n", k, s.Mean, s.Uptime)
-			t, err := time.Parse(time.RFC3339, string(k))
-			if err != nil {
-				return fmt.Errorf("Failed to parse time: %s", err.Error())
-			}
-			status[t] = s
+// sortIncidents sorts incidents oldest first.
+func sortIncidents(incidents []common.Incident) {
+	sort.Slice(incidents, func(a, b int) bool {
+		if incidents[a].Start.Equal(incidents[b].Start) {
+			return incidents[a].ID < incidents[b].ID
 		}
-		return nil
+		return incidents[a].Start.Before(incidents[b].Start)
 	})
-	if err != nil {
-		return status, fmt.Errorf("Failed to query db: %s", err.Error())
-	}
-	return status, nil
 }
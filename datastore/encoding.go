@@ -0,0 +1,256 @@
+package datastore
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/sketch"
+)
+
+// Raw samples are keyed by their time as big-endian nanoseconds since the
+// epoch, so keys sort chronologically. Their values are encoded as
+//
+//	version  byte, sampleVersion
+//	flags    byte, bit 0 set if the probe was up
+//	latency  uvarint, nanoseconds
+//	error    uvarint length followed by the error class
+//	fields   uvarint count followed by, for each field, a uvarint length,
+//	         the name and the value as 8 byte big-endian IEEE 754 bits
+const sampleVersion = 1
+
+var errCorrupt = errors.New("corrupt record")
+
+func timeKey(t time.Time) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(t.UnixNano()))
+	return k
+}
+
+func keyTime(k []byte) (time.Time, error) {
+	if len(k) != 8 {
+		return time.Time{}, fmt.Errorf("Invalid time key of %d bytes", len(k))
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(k))).UTC(), nil
+}
+
+func encodeSample(r common.Response) []byte {
+	b := make([]byte, 0, 16+len(r.Error)+len(r.Fields)*16)
+	var flags byte
+	if r.IsUp {
+		flags |= 1
+	}
+	b = append(b, sampleVersion, flags)
+	b = binary.AppendUvarint(b, uint64(r.Latency))
+	b = binary.AppendUvarint(b, uint64(len(r.Error)))
+	b = append(b, r.Error...)
+	b = binary.AppendUvarint(b, uint64(len(r.Fields)))
+	for name, value := range r.Fields {
+		b = binary.AppendUvarint(b, uint64(len(name)))
+		b = append(b, name...)
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(value))
+	}
+	return b
+}
+
+func decodeSample(t time.Time, b []byte) (common.Response, error) {
+	r := common.Response{Time: t}
+	if len(b) < 2 {
+		return r, errCorrupt
+	}
+	if b[0] != sampleVersion {
+		return r, fmt.Errorf("Unknown sample version %d", b[0])
+	}
+	r.IsUp = b[1]&1 != 0
+	b = b[2:]
+	latency, n := binary.Uvarint(b)
+	if n <= 0 {
+		return r, errCorrupt
+	}
+	r.Latency = time.Duration(latency)
+	b = b[n:]
+	errLen, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b[n:])) < errLen {
+		return r, errCorrupt
+	}
+	r.Error = string(b[n : n+int(errLen)])
+	b = b[n+int(errLen):]
+	count, n := binary.Uvarint(b)
+	if n <= 0 {
+		return r, errCorrupt
+	}
+	b = b[n:]
+	for i := uint64(0); i < count; i++ {
+		nameLen, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b[n:])) < nameLen+8 {
+			return r, errCorrupt
+		}
+		if r.Fields == nil {
+			r.Fields = make(map[string]float64)
+		}
+		name := string(b[n : n+int(nameLen)])
+		b = b[n+int(nameLen):]
+		r.Fields[name] = math.Float64frombits(binary.BigEndian.Uint64(b))
+		b = b[8:]
+	}
+	return r, nil
+}
+
+// Aggregates are keyed like raw samples, by the start of the second, minute,
+// hour or day they cover. Their values are encoded as
+//
+//	version  byte, statusVersion
+//	count    uvarint, number of samples
+//	failures uvarint, number of samples that were down
+//	stats    uptime, mean, standard deviation, min, max and the 50th, 90th,
+//	         95th, 99th and 99.9th percentile, each as 8 byte big-endian
+//	         IEEE 754 bits
+//	fields   like the fields of a raw sample
+//	sketch   uvarint length followed by the binary sketch, 0 if there is none
+//	phases   uvarint number of phase sketches, each a uvarint length and the
+//	         name followed by a sketch encoded like the one above
+//
+// Aggregates written before phase sketches were kept end after the sketch.
+const statusVersion = 1
+
+// statusStats returns pointers to the stats of s in the order they are
+// encoded in.
+func statusStats(s *common.Status) []*float64 {
+	return []*float64{
+		&s.Uptime, &s.Mean, &s.StandardDeviation, &s.Min, &s.Max,
+		&s.Percentile50, &s.Percentile90, &s.Percentile95, &s.Percentile99, &s.Percentile999,
+	}
+}
+
+func encodeStatus(s common.Status) ([]byte, error) {
+	var sk []byte
+	if s.Sketch != nil {
+		var err error
+		sk, err = s.Sketch.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+	b := make([]byte, 0, 96+len(s.Fields)*16+len(sk))
+	b = append(b, statusVersion)
+	b = binary.AppendUvarint(b, uint64(s.Count))
+	b = binary.AppendUvarint(b, uint64(s.Failures))
+	for _, v := range statusStats(&s) {
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(*v))
+	}
+	b = binary.AppendUvarint(b, uint64(len(s.Fields)))
+	for name, value := range s.Fields {
+		b = binary.AppendUvarint(b, uint64(len(name)))
+		b = append(b, name...)
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(value))
+	}
+	b = binary.AppendUvarint(b, uint64(len(sk)))
+	b = append(b, sk...)
+	b = binary.AppendUvarint(b, uint64(len(s.PhaseSketches)))
+	for name, phase := range s.PhaseSketches {
+		sk, err := phase.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		b = binary.AppendUvarint(b, uint64(len(name)))
+		b = append(b, name...)
+		b = binary.AppendUvarint(b, uint64(len(sk)))
+		b = append(b, sk...)
+	}
+	return b, nil
+}
+
+func decodeStatus(b []byte) (common.Status, error) {
+	var s common.Status
+	if len(b) < 1 {
+		return s, errCorrupt
+	}
+	if b[0] != statusVersion {
+		return s, fmt.Errorf("Unknown status version %d", b[0])
+	}
+	b = b[1:]
+	count, n := binary.Uvarint(b)
+	if n <= 0 {
+		return s, errCorrupt
+	}
+	s.Count = int(count)
+	b = b[n:]
+	failures, n := binary.Uvarint(b)
+	if n <= 0 {
+		return s, errCorrupt
+	}
+	s.Failures = int(failures)
+	b = b[n:]
+	stats := statusStats(&s)
+	if len(b) < len(stats)*8 {
+		return s, errCorrupt
+	}
+	for _, v := range stats {
+		*v = math.Float64frombits(binary.BigEndian.Uint64(b))
+		b = b[8:]
+	}
+	fields, n := binary.Uvarint(b)
+	if n <= 0 {
+		return s, errCorrupt
+	}
+	b = b[n:]
+	for i := uint64(0); i < fields; i++ {
+		nameLen, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b[n:])) < nameLen+8 {
+			return s, errCorrupt
+		}
+		if s.Fields == nil {
+			s.Fields = make(map[string]float64)
+		}
+		name := string(b[n : n+int(nameLen)])
+		b = b[n+int(nameLen):]
+		s.Fields[name] = math.Float64frombits(binary.BigEndian.Uint64(b))
+		b = b[8:]
+	}
+	skLen, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b[n:])) < skLen {
+		return s, errCorrupt
+	}
+	if skLen > 0 {
+		s.Sketch = new(sketch.Sketch)
+		err := s.Sketch.UnmarshalBinary(b[n : n+int(skLen)])
+		if err != nil {
+			return s, err
+		}
+	}
+	b = b[n+int(skLen):]
+	if len(b) == 0 {
+		return s, nil
+	}
+	phases, n := binary.Uvarint(b)
+	if n <= 0 {
+		return s, errCorrupt
+	}
+	b = b[n:]
+	for i := uint64(0); i < phases; i++ {
+		nameLen, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b[n:])) < nameLen {
+			return s, errCorrupt
+		}
+		name := string(b[n : n+int(nameLen)])
+		b = b[n+int(nameLen):]
+		skLen, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b[n:])) < skLen {
+			return s, errCorrupt
+		}
+		phase := new(sketch.Sketch)
+		err := phase.UnmarshalBinary(b[n : n+int(skLen)])
+		if err != nil {
+			return s, err
+		}
+		if s.PhaseSketches == nil {
+			s.PhaseSketches = make(map[string]*sketch.Sketch)
+		}
+		s.PhaseSketches[name] = phase
+		b = b[n+int(skLen):]
+	}
+	return s, nil
+}
@@ -0,0 +1,268 @@
+package datastore
+
+import (
+	"encoding/json"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/sketch"
+	"github.com/boltdb/bolt"
+)
+
+func TestTimeKey(t *testing.T) {
+	for _, want := range []time.Time{
+		time.Unix(0, 0).UTC(),
+		time.Date(2026, 10, 18, 9, 7, 4, 123456789, time.UTC),
+	} {
+		got, err := keyTime(timeKey(want))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("keyTime(timeKey(%s)) = %s", want, got)
+		}
+	}
+	// Keys sort like the times they stand for
+	a, b := timeKey(time.Unix(1, 0)), timeKey(time.Unix(256, 0))
+	if string(a) >= string(b) {
+		t.Errorf("Expected key of 1s to sort before key of 256s")
+	}
+	if _, err := keyTime([]byte("2026-10-18T09:07:04Z")); err == nil {
+		t.Errorf("Expected an error for an RFC3339 key")
+	}
+}
+
+func TestSampleRoundTrip(t *testing.T) {
+	now := time.Now().UTC()
+	for _, want := range []common.Response{
+		{Time: now, IsUp: true, Latency: 1234567},
+		{Time: now, Error: "timeout", Latency: 5 * time.Second},
+		{Time: now, IsUp: true, Latency: time.Millisecond, Fields: map[string]float64{"dns": 0.001, "ttfb": 0.25}},
+	} {
+		b := encodeSample(want)
+		got, err := decodeSample(now, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Decoded %+v, want %+v", got, want)
+		}
+		for n := 0; n < len(b); n++ {
+			if _, err := decodeSample(now, b[:n]); err == nil {
+				t.Errorf("Expected an error decoding %d of %d bytes", n, len(b))
+			}
+		}
+	}
+}
+
+// testStatus returns an aggregate of n random latencies.
+func testStatus(r *rand.Rand, n int) common.Status {
+	s := common.Status{
+		Count: n, Failures: 1, Uptime: 100 * float64(n-1) / float64(n),
+		Mean: 0.012, StandardDeviation: 0.003, Min: 0.004, Max: 0.08,
+		Percentile50: 0.011, Percentile90: 0.02, Percentile95: 0.03, Percentile99: 0.05, Percentile999: 0.07,
+		Fields: map[string]float64{"loss": 0, "rtt_avg": 0.012},
+		Sketch: sketch.New(),
+	}
+	for i := 0; i < n; i++ {
+		s.Sketch.Add(0.004 + r.ExpFloat64()/100)
+	}
+	return s
+}
+
+func TestStatusRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	withPhases := testStatus(r, 5)
+	withPhases.PhaseSketches = map[string]*sketch.Sketch{"dns": sketch.New(), "ttfb": sketch.New()}
+	withPhases.PhaseSketches["dns"].Add(0.001)
+	withPhases.PhaseSketches["ttfb"].Add(0.2)
+	for _, want := range []common.Status{
+		{Count: 1, Uptime: 100, Mean: 0.01},
+		testStatus(r, 20),
+		withPhases,
+	} {
+		b, err := encodeStatus(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decodeStatus(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Decoded %+v, want %+v", got, want)
+		}
+		if _, err := decodeStatus(b[:len(b)/2]); err == nil {
+			t.Errorf("Expected an error decoding half of %d bytes", len(b))
+		}
+	}
+}
+
+func TestMigrateStatusEncoding(t *testing.T) {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	at := time.Date(2026, 10, 18, 9, 7, 4, 0, time.UTC)
+	legacy := common.Status{Uptime: 100, Mean: 0.01, Percentile90: 0.02}
+	err = db.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists(seriesBucket)
+		if err != nil {
+			return err
+		}
+		sb, err := root.CreateBucket([]byte("agent/eth0/icmp/192.0.2.1"))
+		if err != nil {
+			return err
+		}
+		b, err := sb.CreateBucket(statusBucket)
+		if err != nil {
+			return err
+		}
+		v, _ := json.Marshal(legacy)
+		return b.Put([]byte(at.Format(time.RFC3339)), v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []int{1, 0} {
+		var n int
+		err = db.Update(func(tx *bolt.Tx) error {
+			n, err = migrateStatusEncoding(tx, "")
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != want {
+			t.Errorf("Expected %d aggregates to be converted, got %d", want, n)
+		}
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(seriesBucket).Bucket([]byte("agent/eth0/icmp/192.0.2.1")).Bucket(statusBucket)
+		got, err := decodeStatus(b.Get(timeKey(at)))
+		if err != nil {
+			return err
+		}
+		// Aggregates of earlier versions count as one sample
+		legacy.Count = 1
+		if !reflect.DeepEqual(got, legacy) {
+			t.Errorf("Converted %+v, want %+v", got, legacy)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// The benchmarks compare the binary encoding with the JSON values under
+// RFC3339 keys that aggregates were stored as before. BenchmarkStatusScan
+// reads a day of per-second aggregates and reports the bytes each takes in
+// the bolt file, page overhead included.
+
+type statusCodec struct {
+	key    func(time.Time) []byte
+	encode func(common.Status) ([]byte, error)
+	decode func([]byte) (common.Status, error)
+}
+
+var statusCodecs = map[string]statusCodec{
+	"binary": {timeKey, encodeStatus, decodeStatus},
+	"json": {
+		func(t time.Time) []byte { return []byte(t.Format(time.RFC3339)) },
+		func(s common.Status) ([]byte, error) { return json.Marshal(s) },
+		func(b []byte) (common.Status, error) {
+			var s common.Status
+			err := json.Unmarshal(b, &s)
+			return s, err
+		},
+	},
+}
+
+const benchmarkSeconds = 24 * 60 * 60
+
+// benchmarkDB stores a day of per-second aggregates with codec.
+func benchmarkDB(b *testing.B, codec statusCodec) (*bolt.DB, int64) {
+	b.Helper()
+	path := filepath.Join(b.TempDir(), "bench.db")
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	r := rand.New(rand.NewSource(1))
+	s := testStatus(r, 5)
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	var size int64
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucket(statusBucket)
+		if err != nil {
+			return err
+		}
+		bucket.FillPercent = 1
+		for i := 0; i < benchmarkSeconds; i++ {
+			v, err := codec.encode(s)
+			if err != nil {
+				return err
+			}
+			if err = bucket.Put(codec.key(start.Add(time.Duration(i)*time.Second)), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		err = db.View(func(tx *bolt.Tx) error {
+			size = tx.Size()
+			return nil
+		})
+	}
+	if err != nil {
+		b.Fatal(err)
+	}
+	return db, size
+}
+
+func BenchmarkStatusEncode(b *testing.B) {
+	s := testStatus(rand.New(rand.NewSource(1)), 5)
+	for name, codec := range statusCodecs {
+		b.Run(name, func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				v, err := codec.encode(s)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(codec.key(time.Time{})) + len(v)
+			}
+			b.ReportMetric(float64(size), "bytes/record")
+		})
+	}
+}
+
+func BenchmarkStatusScan(b *testing.B) {
+	for name, codec := range statusCodecs {
+		b.Run(name, func(b *testing.B) {
+			db, size := benchmarkDB(b, codec)
+			defer db.Close()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := db.View(func(tx *bolt.Tx) error {
+					return tx.Bucket(statusBucket).ForEach(func(k, v []byte) error {
+						_, err := codec.decode(v)
+						return err
+					})
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(size)/benchmarkSeconds, "dbbytes/record")
+			b.ReportMetric(float64(b.N*benchmarkSeconds)/b.Elapsed().Seconds(), "records/s")
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package datastore
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/process"
+)
+
+// MemoryStore is a Store that keeps its data in memory only, for tests and
+// servers that don't need to keep history across restarts.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	series    map[string]*memorySeries
+	incidents []common.Incident   // by ID - 1
+	alerts    []common.AlertEvent // by ID - 1
+	// latestAlerts holds the index in alerts of the latest event of every
+	// rule and series
+	latestAlerts map[[2]string]int
+
+	checkpoints map[string]Checkpoint
+}
+
+type memorySeries struct {
+	labels map[string]string
+	raw    []common.Response                             // sorted by time
+	tiers  map[time.Duration]map[time.Time]common.Status // by resolution
+}
+
+func NewMemory() *MemoryStore {
+	return &MemoryStore{
+		series:       make(map[string]*memorySeries),
+		latestAlerts: make(map[[2]string]int),
+		checkpoints:  make(map[string]Checkpoint),
+	}
+}
+
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// get returns series, creating it if necessary. s.mu must be held.
+func (s *MemoryStore) get(series string) *memorySeries {
+	ms, ok := s.series[series]
+	if !ok {
+		ms = &memorySeries{tiers: make(map[time.Duration]map[time.Time]common.Status)}
+		for _, tier := range tiers {
+			ms.tiers[tier.resolution] = make(map[time.Time]common.Status)
+		}
+		s.series[series] = ms
+	}
+	return ms
+}
+
+func (s *MemoryStore) WriteSample(series string, samples ...common.Response) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(series).writeSamples(samples)
+	return nil
+}
+
+func (ms *memorySeries) writeSamples(samples []common.Response) {
+	for _, r := range samples {
+		i := sort.Search(len(ms.raw), func(i int) bool { return ms.raw[i].Time.After(r.Time) })
+		ms.raw = append(ms.raw, common.Response{})
+		copy(ms.raw[i+1:], ms.raw[i:])
+		ms.raw[i] = r
+	}
+}
+
+func (s *MemoryStore) WriteAggregate(series string, status map[time.Time]common.Status) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(series).writeAggregates(status)
+	return nil
+}
+
+func (ms *memorySeries) writeAggregates(status map[time.Time]common.Status) {
+	for _, tier := range tiers {
+		b := ms.tiers[tier.resolution]
+		for t, st := range process.Downsample(status, tier.resolution) {
+			b[t] = process.Merge(b[t], st)
+		}
+	}
+}
+
+func (s *MemoryStore) WriteFlush(series string, samples []common.Response, status map[time.Time]common.Status, c Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ms := s.get(series)
+	ms.writeSamples(samples)
+	ms.writeAggregates(status)
+	s.checkpoints[series] = c
+	return nil
+}
+
+func (s *MemoryStore) Checkpoints() (map[string]Checkpoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	checkpoints := make(map[string]Checkpoint, len(s.checkpoints))
+	for series, c := range s.checkpoints {
+		checkpoints[series] = c
+	}
+	return checkpoints, nil
+}
+
+func (s *MemoryStore) PutAggregates(series string, resolution time.Duration, status map[time.Time]common.Status) error {
+	tier, err := tierOf(resolution)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.get(series).tiers[tier.resolution]
+	for t, st := range status {
+		b[t] = st
+	}
+	return nil
+}
+
+func (s *MemoryStore) SetLabels(series string, l map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(series).labels = l
+	return nil
+}
+
+func (s *MemoryStore) Labels(series string) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ms, ok := s.series[series]
+	if !ok {
+		return nil, ErrUnknownSeries
+	}
+	return ms.labels, nil
+}
+
+func (s *MemoryStore) ReadRaw(series string, from, to time.Time) ([]common.Response, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ms, ok := s.series[series]
+	if !ok {
+		return nil, ErrUnknownSeries
+	}
+	var samples []common.Response
+	i := sort.Search(len(ms.raw), func(i int) bool { return !ms.raw[i].Time.Before(from) })
+	for ; i < len(ms.raw) && !ms.raw[i].Time.After(to); i++ {
+		samples = append(samples, ms.raw[i])
+	}
+	return samples, nil
+}
+
+func (s *MemoryStore) ListSeries() ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var series []string
+	for key := range s.series {
+		series = append(series, key)
+	}
+	sort.Strings(series)
+	return series, nil
+}
+
+func (s *MemoryStore) Query(series string, from, to time.Time, step time.Duration) (map[time.Time]common.Status, error) {
+	tier := tierFor(step)
+	status := make(map[time.Time]common.Status)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ms, ok := s.series[series]
+	if !ok {
+		return status, ErrUnknownSeries
+	}
+	min := from.Truncate(tier.resolution)
+	for t, st := range ms.tiers[tier.resolution] {
+		if !t.Before(min) && !t.After(to) {
+			status[t] = st
+		}
+	}
+	return status, nil
+}
+
+func (s *MemoryStore) DeleteBefore(raw, status time.Time) (int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var samples, aggregates int
+	for _, ms := range s.series {
+		if !raw.IsZero() {
+			i := sort.Search(len(ms.raw), func(i int) bool { return !ms.raw[i].Time.Before(raw) })
+			ms.raw = append(ms.raw[:0], ms.raw[i:]...)
+			samples += i
+		}
+		if !status.IsZero() {
+			b := ms.tiers[tiers[0].resolution]
+			for t := range b {
+				if t.Before(status) {
+					delete(b, t)
+					aggregates++
+				}
+			}
+		}
+	}
+	return samples, aggregates, nil
+}
+
+func (s *MemoryStore) PutIncident(i *common.Incident) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i.ID == 0 {
+		s.incidents = append(s.incidents, common.Incident{})
+		i.ID = uint64(len(s.incidents))
+	}
+	if i.ID > uint64(len(s.incidents)) {
+		return fmt.Errorf("Unknown incident %d", i.ID)
+	}
+	stored := *i
+	stored.Series = append([]string(nil), i.Series...)
+	s.incidents[i.ID-1] = stored
+	return nil
+}
+
+func (s *MemoryStore) Incidents(from, to time.Time) ([]common.Incident, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var incidents []common.Incident
+	for _, i := range s.incidents {
+		if overlaps(i, from, to) {
+			i.Series = append([]string(nil), i.Series...)
+			incidents = append(incidents, i)
+		}
+	}
+	sortIncidents(incidents)
+	return incidents, nil
+}
+
+func (s *MemoryStore) AddAlertEvent(e *common.AlertEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e.ID = uint64(len(s.alerts) + 1)
+	s.alerts = append(s.alerts, *e)
+	s.latestAlerts[[2]string{e.Rule, e.Series}] = len(s.alerts) - 1
+	return nil
+}
+
+func (s *MemoryStore) LatestAlertEvents() ([]common.AlertEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := make([]common.AlertEvent, 0, len(s.latestAlerts))
+	for _, i := range s.latestAlerts {
+		events = append(events, s.alerts[i])
+	}
+	return events, nil
+}
+
+func (s *MemoryStore) AlertEvents(from, to time.Time) ([]common.AlertEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var events []common.AlertEvent
+	for _, e := range s.alerts {
+		if !e.Time.Before(from) && !e.Time.After(to) {
+			events = append(events, e)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	return events, nil
+}
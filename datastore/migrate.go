@@ -0,0 +1,356 @@
+package datastore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/boltdb/bolt"
+)
+
+// The meta bucket holds the schema version of the db, the number of the
+// last migration that ran on it, as a big-endian uint64. Dbs written before
+// the meta bucket existed are at version 0.
+var (
+	metaBucket = []byte("meta")
+	versionKey = []byte("version")
+)
+
+// migration changes the layout of the db from version-1 to version. It
+// returns how many records it changed and must be idempotent, since a
+// crash before its transaction commits makes it run again. agent names the
+// agent whose results the db holds, for dbs that did not record it.
+type migration struct {
+	version     int
+	description string
+	run         func(tx *bolt.Tx, agent string) (int, error)
+}
+
+// migrations are ordered by version, new ones are appended.
+var migrations = []migration{
+	{1, "move the wifi and lan buckets into series buckets", migrateLegacyBuckets},
+	{2, "encode aggregates in binary under nanosecond keys", migrateStatusEncoding},
+	{3, "roll up aggregates that have no coarser tiers", migrateRollups},
+	{4, "index the latest alert event of every rule and series", migrateAlertState},
+}
+
+// schemaVersion is the version a db has after all migrations ran.
+var schemaVersion = migrations[len(migrations)-1].version
+
+func readVersion(tx *bolt.Tx) int {
+	b := tx.Bucket(metaBucket)
+	if b == nil {
+		return 0
+	}
+	v := b.Get(versionKey)
+	if len(v) != 8 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint64(v))
+}
+
+func writeVersion(tx *bolt.Tx, version int) error {
+	b, err := tx.CreateBucketIfNotExists(metaBucket)
+	if err != nil {
+		return fmt.Errorf("create meta bucket: %s", err.Error())
+	}
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, uint64(version))
+	return b.Put(versionKey, v)
+}
+
+// Migrate runs the pending migrations of the db at path. With dryRun set
+// it only logs what they would change. Results of dbs written before series
+// had agents are moved to series of agent.
+func Migrate(path, agent string, dryRun bool) error {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return fmt.Errorf("Failed to open db: %s", err.Error())
+	}
+	defer db.Close()
+	return migrate(db, path, agent, dryRun)
+}
+
+// migrate brings db up to schemaVersion. A new db is stamped with it right
+// away, an existing one is copied to a backup file next to path before its
+// first migration runs. Every migration runs in a transaction of its own
+// together with the version bump.
+func migrate(db *bolt.DB, path, agent string, dryRun bool) error {
+	var version int
+	empty := true
+	err := db.View(func(tx *bolt.Tx) error {
+		version = readVersion(tx)
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			empty = false
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if version > schemaVersion {
+		return fmt.Errorf("Db has schema version %d, this version of checker only knows up to %d", version, schemaVersion)
+	}
+	if version == schemaVersion {
+		if dryRun {
+			log.Printf("Db is at schema version %d, nothing to migrate\n", version)
+		}
+		return nil
+	}
+	if empty {
+		if dryRun {
+			log.Printf("Db is new, it would be created at schema version %d\n", schemaVersion)
+			return nil
+		}
+		return db.Update(func(tx *bolt.Tx) error {
+			return writeVersion(tx, schemaVersion)
+		})
+	}
+	if !dryRun {
+		backup := fmt.Sprintf("%s.v%d.bak", path, version)
+		err = db.View(func(tx *bolt.Tx) error {
+			return tx.CopyFile(backup, 0600)
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to back up db before migrating: %s", err.Error())
+		}
+		log.Printf("Backed up db at schema version %d to %s\n", version, backup)
+	}
+	// A dry run applies all migrations in one transaction that is rolled
+	// back, so later ones see what earlier ones would have changed
+	var tx *bolt.Tx
+	if dryRun {
+		tx, err = db.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+	}
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+		run := func(tx *bolt.Tx) error {
+			if _, err := tx.CreateBucketIfNotExists(seriesBucket); err != nil {
+				return fmt.Errorf("create bucket: %s", err.Error())
+			}
+			n, err := m.run(tx, agent)
+			if err != nil {
+				return fmt.Errorf("Migration %d failed: %s", m.version, err.Error())
+			}
+			if dryRun {
+				log.Printf("Migration %d would %s, changing %d records\n", m.version, m.description, n)
+			} else {
+				log.Printf("Migration %d: %s, changed %d records\n", m.version, m.description, n)
+			}
+			return writeVersion(tx, m.version)
+		}
+		if dryRun {
+			err = run(tx)
+		} else {
+			err = db.Update(run)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateLegacyBuckets moves the status buckets that the first versions
+// kept per interface into series buckets, named like the series of the
+// probes legacy configs get, under agent. Their latency subbuckets were
+// created but never written to, anything in them is skipped.
+func migrateLegacyBuckets(tx *bolt.Tx, agent string) (int, error) {
+	legacy := []struct {
+		bucket, ief, target string
+	}{
+		{"wifi", config.C.WifiIef, "8.8.4.4:53"},
+		{"lan", config.C.LanIef, "8.8.8.8:53"},
+	}
+	total := 0
+	for _, l := range legacy {
+		old := tx.Bucket([]byte(l.bucket))
+		if old == nil {
+			continue
+		}
+		ief := l.ief
+		if ief == "" {
+			ief = l.bucket
+		}
+		series := common.Series{Agent: agent, Interface: ief, Probe: "tcp", Target: l.target}
+		sb, err := tx.Bucket(seriesBucket).CreateBucketIfNotExists([]byte(series.Key()))
+		if err != nil {
+			return total, fmt.Errorf("create series bucket: %s", err.Error())
+		}
+		if status := old.Bucket(statusBucket); status != nil {
+			b, err := sb.CreateBucketIfNotExists(statusBucket)
+			if err != nil {
+				return total, fmt.Errorf("create status bucket: %s", err.Error())
+			}
+			err = status.ForEach(func(k, v []byte) error {
+				total++
+				return b.Put(k, v)
+			})
+			if err != nil {
+				return total, err
+			}
+		}
+		if latency := old.Bucket([]byte("latency")); latency != nil {
+			if n := latency.Stats().KeyN; n > 0 {
+				log.Printf("Skipped %d records of the %s latency bucket, their format is unknown\n", n, l.bucket)
+			}
+		}
+		err = tx.DeleteBucket([]byte(l.bucket))
+		if err != nil {
+			return total, err
+		}
+		log.Printf("Moved the %s bucket to %s\n", l.bucket, series.Key())
+	}
+	return total, nil
+}
+
+// forEachSeries calls fn with the bucket of every series.
+func forEachSeries(tx *bolt.Tx, fn func(key []byte, sb *bolt.Bucket) error) error {
+	root := tx.Bucket(seriesBucket)
+	return root.ForEach(func(k, v []byte) error {
+		// Buckets have nil values
+		if v != nil {
+			return nil
+		}
+		return fn(k, root.Bucket(k))
+	})
+}
+
+// migrateStatusEncoding converts aggregates stored as JSON under RFC3339 keys
+// to the binary encoding. Converted keys are 8 bytes long, so aggregates
+// that were converted already are left alone.
+func migrateStatusEncoding(tx *bolt.Tx, agent string) (int, error) {
+	total := 0
+	err := forEachSeries(tx, func(key []byte, sb *bolt.Bucket) error {
+		for _, tier := range tiers {
+			b := sb.Bucket(tier.bucket)
+			if b == nil {
+				continue
+			}
+			n, err := migrateStatusBucket(b)
+			if err != nil {
+				return fmt.Errorf("Failed to migrate %s of %s: %s", tier.bucket, key, err.Error())
+			}
+			total += n
+		}
+		return nil
+	})
+	return total, err
+}
+
+func migrateStatusBucket(b *bolt.Bucket) (int, error) {
+	legacy := make(map[string][]byte)
+	c := b.Cursor()
+	// RFC3339 keys start with an ASCII digit, so they sort after the binary
+	// keys of any time before 2084
+	for k, v := c.Last(); k != nil && len(k) != 8; k, v = c.Prev() {
+		legacy[string(k)] = append([]byte(nil), v...)
+	}
+	for k, v := range legacy {
+		t, err := time.Parse(time.RFC3339, k)
+		if err != nil {
+			return 0, fmt.Errorf("Failed to parse time: %s", err.Error())
+		}
+		var s common.Status
+		err = json.Unmarshal(v, &s)
+		if err != nil {
+			return 0, fmt.Errorf("Failed to decode bytes: %s", err.Error())
+		}
+		// Versions that did not record the count wrote one aggregate per
+		// second, count it as a sample so that merges weight them equally
+		if s.Count == 0 {
+			s.Count = 1
+		}
+		encoded, err := encodeStatus(s)
+		if err != nil {
+			return 0, err
+		}
+		err = b.Delete([]byte(k))
+		if err != nil {
+			return 0, err
+		}
+		err = b.Put(timeKey(t), encoded)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(legacy), nil
+}
+
+// migrateRollups fills the coarser tiers of series that only have per-second
+// aggregates, which is what dbs written before the tiers existed have. It
+// rolls up a day at a time, the resolution of the coarsest tier, so that
+// every bucket of a tier is merged from all of its seconds at once.
+func migrateRollups(tx *bolt.Tx, agent string) (int, error) {
+	total := 0
+	err := forEachSeries(tx, func(key []byte, sb *bolt.Bucket) error {
+		b := sb.Bucket(statusBucket)
+		if b == nil || sb.Bucket(tiers[1].bucket) != nil {
+			return nil
+		}
+		day := tiers[len(tiers)-1].resolution
+		k, _ := b.Cursor().First()
+		for k != nil {
+			start, err := keyTime(k)
+			if err != nil {
+				return err
+			}
+			start = start.Truncate(day)
+			end := timeKey(start.Add(day))
+			status := make(map[time.Time]common.Status)
+			// Rolling up writes to the series bucket, so every day gets a
+			// fresh cursor
+			c := b.Cursor()
+			var v []byte
+			for k, v = c.Seek(timeKey(start)); k != nil && bytes.Compare(k, end) < 0; k, v = c.Next() {
+				t, err := keyTime(k)
+				if err != nil {
+					return err
+				}
+				s, err := decodeStatus(v)
+				if err != nil {
+					return fmt.Errorf("Failed to decode aggregate at %s: %s", t, err.Error())
+				}
+				status[t] = s
+			}
+			total += len(status)
+			if err = rollup(sb, status); err != nil {
+				return err
+			}
+			k, _ = b.Cursor().Seek(end)
+		}
+		return nil
+	})
+	return total, err
+}
+
+// migrateAlertState fills the index of the latest alert events from the
+// alert history, oldest first, so that later events replace earlier ones.
+func migrateAlertState(tx *bolt.Tx, agent string) (int, error) {
+	b := tx.Bucket(alertsBucket)
+	if b == nil {
+		return 0, nil
+	}
+	total := 0
+	err := b.ForEach(func(k, v []byte) error {
+		var e common.AlertEvent
+		err := json.Unmarshal(v, &e)
+		if err != nil {
+			return fmt.Errorf("Failed to decode alert event: %s", err.Error())
+		}
+		total++
+		return putAlertState(tx, &e, v)
+	})
+	return total, err
+}
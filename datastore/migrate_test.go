@@ -0,0 +1,116 @@
+package datastore
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/boltdb/bolt"
+)
+
+func TestMigrateLegacyDB(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my.db")
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	midnight := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	// What the first versions wrote, across a day boundary
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("wifi"))
+		if err != nil {
+			return err
+		}
+		if _, err = b.CreateBucket([]byte("latency")); err != nil {
+			return err
+		}
+		status, err := b.CreateBucket(statusBucket)
+		if err != nil {
+			return err
+		}
+		for _, at := range []time.Time{midnight.Add(-time.Second), midnight, midnight.Add(time.Second)} {
+			v, _ := json.Marshal(common.Status{Uptime: 100, Mean: 0.01, Percentile90: 0.02})
+			if err = status.Put([]byte(at.Format(time.RFC3339)), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Migrate(path, "old-laptop", false); err != nil {
+		t.Fatal(err)
+	}
+	store, err := OpenBolt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	series := "old-laptop/wifi/tcp/8.8.4.4:53"
+	for _, test := range []struct {
+		step  time.Duration
+		count map[time.Time]int
+	}{
+		{time.Second, map[time.Time]int{midnight.Add(-time.Second): 1, midnight: 1, midnight.Add(time.Second): 1}},
+		{time.Minute, map[time.Time]int{midnight.Add(-time.Minute): 1, midnight: 2}},
+		{24 * time.Hour, map[time.Time]int{midnight.Add(-24 * time.Hour): 1, midnight: 2}},
+	} {
+		status, err := store.Query(series, midnight.Add(-48*time.Hour), midnight.Add(24*time.Hour), test.step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(status) != len(test.count) {
+			t.Errorf("Expected %d aggregates at %s, got %v", len(test.count), test.step, status)
+		}
+		for at, count := range test.count {
+			if status[at].Count != count {
+				t.Errorf("Expected a count of %d at %s, got %d", count, at, status[at].Count)
+			}
+		}
+	}
+}
+
+func TestMigrateAlertState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my.db")
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	// The history of a db at schema version 3, without the index
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket(alertsBucket)
+		if err != nil {
+			return err
+		}
+		for i, state := range []string{"pending", "firing", "resolved"} {
+			e := common.AlertEvent{ID: uint64(i + 1), Rule: "uptime", Series: "agent/eth0/icmp/192.0.2.1", State: state, Time: start.Add(time.Duration(i) * time.Minute)}
+			v, _ := json.Marshal(e)
+			if err = b.Put(binary.BigEndian.AppendUint64(timeKey(e.Time), e.ID), v); err != nil {
+				return err
+			}
+		}
+		return writeVersion(tx, 3)
+	})
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := OpenBolt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	events, err := store.LatestAlertEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].State != "resolved" {
+		t.Errorf("Expected the resolved event to be the latest, got %+v", events)
+	}
+}
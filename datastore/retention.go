@@ -0,0 +1,34 @@
+package datastore
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alexgear/checker/config"
+)
+
+// expireBatch bounds the number of keys deleted per transaction, so that
+// expiring a large backlog does not block writers for long.
+const expireBatch = 10000
+
+// Expire deletes raw samples and per-second aggregates of store that are
+// older than their configured retention.
+func Expire(store Store) error {
+	now := time.Now().UTC()
+	var statusBefore time.Time
+	if config.C.StatusRetention.Duration > 0 {
+		statusBefore = now.Add(-config.C.StatusRetention.Duration)
+	}
+	samples, aggregates, err := store.DeleteBefore(now.Add(-config.C.RawRetention.Duration), statusBefore)
+	if err != nil {
+		return fmt.Errorf("Failed to expire data: %s", err.Error())
+	}
+	if samples > 0 {
+		log.Printf("Expired %d raw samples\n", samples)
+	}
+	if aggregates > 0 {
+		log.Printf("Expired %d aggregates\n", aggregates)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package datastore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/process"
+	"github.com/boltdb/bolt"
+)
+
+// tier is a resolution aggregates are kept at. Every tier has its own
+// subbucket in the series bucket, keyed like the per-second status bucket.
+type tier struct {
+	resolution time.Duration
+	bucket     []byte
+}
+
+// tiers are ordered from the finest to the coarsest resolution. The first
+// one holds what FlushCache computes, the others are rolled up from it.
+var tiers = []tier{
+	{time.Second, statusBucket},
+	{time.Minute, []byte("status.1m")},
+	{time.Hour, []byte("status.1h")},
+	{24 * time.Hour, []byte("status.1d")},
+}
+
+// tierFor returns the coarsest tier whose resolution is at most step.
+func tierFor(step time.Duration) tier {
+	t := tiers[0]
+	for _, candidate := range tiers[1:] {
+		if candidate.resolution <= step {
+			t = candidate
+		}
+	}
+	return t
+}
+
+// Resolutions returns the resolutions of the tiers, finest first.
+func Resolutions() []time.Duration {
+	resolutions := make([]time.Duration, len(tiers))
+	for i, tier := range tiers {
+		resolutions[i] = tier.resolution
+	}
+	return resolutions
+}
+
+// tierOf returns the tier of the given resolution.
+func tierOf(resolution time.Duration) (tier, error) {
+	for _, t := range tiers {
+		if t.resolution == resolution {
+			return t, nil
+		}
+	}
+	return tier{}, fmt.Errorf("No tier has a resolution of %s", resolution)
+}
+
+// rollup merges per-second aggregates into every coarser tier of the series
+// bucket sb.
+func rollup(sb *bolt.Bucket, status map[time.Time]common.Status) error {
+	for _, tier := range tiers[1:] {
+		b, err := sb.CreateBucketIfNotExists(tier.bucket)
+		if err != nil {
+			return fmt.Errorf("create rollup bucket: %s", err.Error())
+		}
+		// Merge in memory first, there are many seconds per bucket
+		for t, s := range process.Downsample(status, tier.resolution) {
+			err = mergeStatus(b, t, s)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeStatus stores s under t in b, merged with what is stored there
+// already.
+func mergeStatus(b *bolt.Bucket, t time.Time, s common.Status) error {
+	key := timeKey(t)
+	if v := b.Get(key); v != nil {
+		stored, err := decodeStatus(v)
+		if err != nil {
+			return fmt.Errorf("Failed to decode aggregate at %s: %s", t, err.Error())
+		}
+		s = process.Merge(stored, s)
+	}
+	sEncoded, err := encodeStatus(s)
+	if err != nil {
+		return fmt.Errorf("Failed to encode aggregate: %s", err.Error())
+	}
+	err = b.Put(key, sEncoded)
+	if err != nil {
+		return fmt.Errorf("update bucket: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package datastore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+// The write-ahead log keeps the responses that are cached but not flushed
+// yet, so that they survive a restart. It is a directory of numbered
+// segments, only the newest of which is written to. Every record is
+//
+//	length   4 byte big-endian length of the payload
+//	checksum 4 byte big-endian CRC-32 (IEEE) of the payload
+//	payload  uvarint length followed by the series key, the time as 8 byte
+//	         big-endian nanoseconds and the response encoded like a raw
+//	         sample
+//
+// A record that is cut short or fails its checksum ends a segment, it was
+// being written when the server went down.
+//
+// Every Flush seals the segment written to and starts a new one. Sealed
+// segments are removed once every response in them was flushed, rather
+// than rewriting what is still cached into a new segment.
+const walSuffix = ".wal"
+
+type walEntry struct {
+	series   string
+	response common.Response
+	pos      uint64 // position in the log, see wal.position
+}
+
+// segment is a sealed segment along with the latest second it holds a
+// response of.
+type segment struct {
+	seq  uint64
+	last time.Time
+}
+
+// wal must only be used with walMu of the Cache it belongs to held.
+type wal struct {
+	dir    string
+	seq    uint64
+	n      uint64    // records in the segment written to
+	last   time.Time // latest second of a record in it
+	f      *os.File
+	w      *bufio.Writer
+	sealed []segment
+}
+
+// openWAL opens the log in dir and returns the entries of all its segments,
+// oldest first. New entries go to a new segment, numbered after minSeq at
+// least, so that positions keep growing should dir have been emptied.
+func openWAL(dir string, minSeq uint64) (*wal, []walEntry, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to create wal directory: %s", err.Error())
+	}
+	segments, err := walSegments(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := &wal{dir: dir, seq: minSeq}
+	var entries []walEntry
+	for _, seq := range segments {
+		e, err := readSegment(walPath(dir, seq))
+		if err != nil {
+			return nil, nil, err
+		}
+		sealed := segment{seq: seq}
+		for i := range e {
+			e[i].pos = seq<<32 | uint64(i+1)
+			if t := walSecond(e[i].response); t.After(sealed.last) {
+				sealed.last = t
+			}
+		}
+		l.sealed = append(l.sealed, sealed)
+		entries = append(entries, e...)
+		if seq > l.seq {
+			l.seq = seq
+		}
+	}
+	l.f, l.w, err = l.create(l.seq + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.seq++
+	return l, entries, nil
+}
+
+// walSecond returns the second a Cache keeps r under.
+func walSecond(r common.Response) time.Time {
+	return r.Time.UTC().Round(time.Second)
+}
+
+func walPath(dir string, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%016d%s", seq, walSuffix))
+}
+
+// walSegments returns the sequence numbers of the segments in dir, sorted.
+func walSegments(dir string) ([]uint64, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*"+walSuffix))
+	if err != nil {
+		return nil, err
+	}
+	var segments []uint64
+	for _, name := range names {
+		seq, err := strconv.ParseUint(strings.TrimSuffix(filepath.Base(name), walSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, seq)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+func readSegment(path string) ([]walEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open wal segment: %s", err.Error())
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var entries []walEntry
+	header := make([]byte, 8)
+	for {
+		_, err = io.ReadFull(r, header)
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			log.Printf("Ignoring torn record at the end of %s\n", path)
+			return entries, nil
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(header))
+		_, err = io.ReadFull(r, payload)
+		if err != nil || crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			log.Printf("Ignoring torn record at the end of %s\n", path)
+			return entries, nil
+		}
+		e, err := decodeWALEntry(payload)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode wal record of %s: %s", path, err.Error())
+		}
+		entries = append(entries, e)
+	}
+}
+
+func (l *wal) create(seq uint64) (*os.File, *bufio.Writer, error) {
+	f, err := os.OpenFile(walPath(l.dir, seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to create wal segment: %s", err.Error())
+	}
+	return f, bufio.NewWriter(f), nil
+}
+
+func (l *wal) append(series string, r common.Response) error {
+	err := writeWALEntry(l.w, series, r)
+	if err != nil {
+		return err
+	}
+	l.n++
+	if t := walSecond(r); t.After(l.last) {
+		l.last = t
+	}
+	return nil
+}
+
+// position returns the position of the latest record appended, the sequence
+// number of its segment in the upper and its number within the segment in
+// the lower 32 bits.
+func (l *wal) position() uint64 {
+	return l.seq<<32 | l.n
+}
+
+func writeWALEntry(w io.Writer, series string, r common.Response) error {
+	payload := make([]byte, 0, 32+len(series))
+	payload = binary.AppendUvarint(payload, uint64(len(series)))
+	payload = append(payload, series...)
+	payload = append(payload, timeKey(r.Time)...)
+	payload = append(payload, encodeSample(r)...)
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header, uint32(len(payload)))
+	binary.BigEndian.PutUint32(header[4:], crc32.ChecksumIEEE(payload))
+	_, err := w.Write(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(payload)
+	return err
+}
+
+func decodeWALEntry(b []byte) (walEntry, error) {
+	var e walEntry
+	n, size := binary.Uvarint(b)
+	if size <= 0 || uint64(len(b[size:])) < n+8 {
+		return e, errCorrupt
+	}
+	e.series = string(b[size : size+int(n)])
+	b = b[size+int(n):]
+	t, err := keyTime(b[:8])
+	if err != nil {
+		return e, err
+	}
+	e.response, err = decodeSample(t, b[8:])
+	return e, err
+}
+
+// sync makes everything appended so far durable.
+func (l *wal) sync() error {
+	err := l.w.Flush()
+	if err != nil {
+		return fmt.Errorf("Failed to write wal: %s", err.Error())
+	}
+	err = l.f.Sync()
+	if err != nil {
+		return fmt.Errorf("Failed to sync wal: %s", err.Error())
+	}
+	return nil
+}
+
+// rotate seals the segment written to and starts a new one.
+func (l *wal) rotate() error {
+	f, w, err := l.create(l.seq + 1)
+	if err != nil {
+		return err
+	}
+	err = l.close()
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	l.sealed = append(l.sealed, segment{l.seq, l.last})
+	l.seq++
+	l.n = 0
+	l.last = time.Time{}
+	l.f, l.w = f, w
+	return nil
+}
+
+// truncate removes the sealed segments that only hold responses of seconds
+// before cutoff. They must have been flushed.
+func (l *wal) truncate(cutoff time.Time) error {
+	kept := l.sealed[:0]
+	for i, s := range l.sealed {
+		if !s.last.Before(cutoff) {
+			kept = append(kept, s)
+			continue
+		}
+		err := os.Remove(walPath(l.dir, s.seq))
+		if err != nil && !os.IsNotExist(err) {
+			l.sealed = append(kept, l.sealed[i:]...)
+			return fmt.Errorf("Failed to remove wal segment: %s", err.Error())
+		}
+	}
+	l.sealed = kept
+	return nil
+}
+
+func (l *wal) close() error {
+	err := l.sync()
+	if err != nil {
+		return err
+	}
+	return l.f.Close()
+}
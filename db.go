@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/datastore"
+)
+
+const dbUsage = `usage: checker db <command> [flags]
+
+commands:
+  migrate            migrate the db to the current schema
+  restore SNAPSHOT   replace the db with a snapshot from /admin/backup
+  verify             check the db for consistency and summarize its series`
+
+// dbCommand runs the "checker db" subcommand given by args.
+func dbCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New(dbUsage)
+	}
+	fs := flag.NewFlagSet("db "+args[0], flag.ExitOnError)
+	path := fs.String("db", config.C.DBPath, "path of the db")
+	switch args[0] {
+	case "migrate":
+		dryRun := fs.Bool("dry-run", false, "only log what the migrations would change")
+		agent := fs.String("agent", config.C.Agent, "agent that wrote the results of dbs from before series had agents")
+		fs.Parse(args[1:])
+		return datastore.Migrate(*path, *agent, *dryRun)
+	case "restore":
+		fs.Parse(args[1:])
+		if fs.NArg() != 1 {
+			return errors.New("usage: checker db restore [-db path] SNAPSHOT")
+		}
+		return datastore.Restore(fs.Arg(0), *path)
+	case "verify":
+		fs.Parse(args[1:])
+		return verify(*path)
+	}
+	return fmt.Errorf("unknown db command %q\n%s", args[0], dbUsage)
+}
+
+// verify prints the report of datastore.Verify and fails if the db is
+// inconsistent.
+func verify(path string) error {
+	r, err := datastore.Verify(path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Schema version %d, %d series\n\n", r.Version, len(r.Series))
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "SERIES\tRAW\tAGGREGATES\tFROM\tTO")
+	for _, s := range r.Series {
+		from, to := "-", "-"
+		if !s.From.IsZero() {
+			from, to = s.From.Format(time.RFC3339), s.To.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\n", s.Series, s.Raw, s.Aggregates, from, to)
+	}
+	w.Flush()
+	if len(r.Errors) > 0 {
+		fmt.Println()
+		for _, e := range r.Errors {
+			fmt.Println(e)
+		}
+		return fmt.Errorf("Db is inconsistent, found %d errors", len(r.Errors))
+	}
+	return nil
+}
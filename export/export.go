@@ -0,0 +1,247 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/datastore"
+)
+
+// chunk is the span of raw samples read from the store at once, so that
+// exporting a long range does not need to hold it in memory.
+const chunk = time.Hour
+
+// ImportFlushEvery bounds how many imported results or aggregates are held
+// before they are written to the db, so that a large import does not pile
+// up in memory.
+const ImportFlushEvery = 100000
+
+// Export writes the raw samples of series between from and to, both
+// included, to enc, series by series and oldest first, along with the
+// labels of each series. All series are exported if none are given. It
+// returns how many samples it wrote.
+func Export(enc Encoder, store datastore.Store, series []string, from, to time.Time) (int, error) {
+	var err error
+	if len(series) == 0 {
+		series, err = store.ListSeries()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := 0
+	for _, key := range series {
+		s, err := common.ParseSeries(key)
+		if err != nil {
+			return n, err
+		}
+		labels, err := store.Labels(key)
+		if err != nil {
+			return n, fmt.Errorf("%s: %s", key, err.Error())
+		}
+		for start := from; !start.After(to); start = start.Add(chunk) {
+			end := start.Add(chunk - 1)
+			if end.After(to) {
+				end = to
+			}
+			samples, err := store.ReadRaw(key, start, end)
+			if err != nil {
+				return n, fmt.Errorf("%s: %s", key, err.Error())
+			}
+			for _, r := range samples {
+				err = enc.Encode(common.Result{Series: s, Labels: labels, Response: r})
+				if err != nil {
+					return n, err
+				}
+				n++
+			}
+		}
+	}
+	return n, enc.Flush()
+}
+
+// Import decodes results from dec until it is exhausted and hands each to
+// write. Results of a second that store has an aggregate of already are
+// skipped, so that importing the same results twice does not count them
+// twice. The results of a second must follow each other, as Export writes
+// them. It returns how many results it read and how many of them it
+// skipped.
+func Import(dec Decoder, store datastore.Store, write func(common.Result) error) (int, int, error) {
+	n, skipped := 0, 0
+	// The second of every series that results were read of last, and
+	// whether it is skipped
+	seconds := make(map[string]time.Time)
+	skipping := make(map[string]bool)
+	for {
+		r, err := dec.Decode()
+		if err == io.EOF {
+			return n, skipped, nil
+		}
+		if err != nil {
+			return n, skipped, fmt.Errorf("Failed to decode result %d: %s", n+1, err.Error())
+		}
+		err = r.Series.Validate()
+		if err != nil {
+			return n, skipped, fmt.Errorf("Result %d: %s", n+1, err.Error())
+		}
+		key := r.Series.Key()
+		// The second a Cache keeps the response under
+		second := r.Response.Time.UTC().Round(time.Second)
+		if t, ok := seconds[key]; !ok || !t.Equal(second) {
+			seconds[key] = second
+			skipping[key], err = stored(store, key, second)
+			if err != nil {
+				return n, skipped, err
+			}
+		}
+		if skipping[key] {
+			n++
+			skipped++
+			continue
+		}
+		err = write(r)
+		if err != nil {
+			return n, skipped, err
+		}
+		n++
+	}
+}
+
+// stored reports whether store has an aggregate of series at the second t.
+func stored(store datastore.Store, series string, t time.Time) (bool, error) {
+	status, err := store.Query(series, t, t, time.Second)
+	if err == datastore.ErrUnknownSeries {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return len(status) > 0, nil
+}
+
+// Aggregate is what ExportAggregates writes for every aggregate, as JSON on
+// a line of its own.
+type Aggregate struct {
+	Series     common.Series     `json:"series"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Resolution time.Duration     `json:"resolution"` // Nanoseconds
+	Time       time.Time         `json:"time"`
+	Status     common.Status     `json:"status"`
+}
+
+// ExportAggregates writes the aggregates of every tier of series between
+// from and to to w as JSON Lines, series by series, finest tier first and
+// oldest first. Aggregates keep their sketches, which no other format has
+// room for. All series are exported if none are given. It returns how many
+// aggregates it wrote.
+func ExportAggregates(w io.Writer, store datastore.Store, series []string, from, to time.Time) (int, error) {
+	var err error
+	if len(series) == 0 {
+		series, err = store.ListSeries()
+		if err != nil {
+			return 0, err
+		}
+	}
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	n := 0
+	for _, key := range series {
+		s, err := common.ParseSeries(key)
+		if err != nil {
+			return n, err
+		}
+		labels, err := store.Labels(key)
+		if err != nil {
+			return n, fmt.Errorf("%s: %s", key, err.Error())
+		}
+		for _, resolution := range datastore.Resolutions() {
+			// Chunks start at the start of an aggregate, so that no
+			// aggregate is in two of them
+			span := chunk
+			if resolution > span {
+				span = resolution
+			}
+			for start := from.Truncate(resolution); !start.After(to); start = start.Add(span) {
+				end := start.Add(span - 1)
+				if end.After(to) {
+					end = to
+				}
+				status, err := store.Query(key, start, end, resolution)
+				if err != nil {
+					return n, fmt.Errorf("%s: %s", key, err.Error())
+				}
+				times := make([]time.Time, 0, len(status))
+				for t := range status {
+					times = append(times, t)
+				}
+				sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+				for _, t := range times {
+					err = enc.Encode(Aggregate{Series: s, Labels: labels, Resolution: resolution, Time: t, Status: status[t]})
+					if err != nil {
+						return n, err
+					}
+					n++
+				}
+			}
+		}
+	}
+	return n, bw.Flush()
+}
+
+// ImportAggregates reads what ExportAggregates wrote from r and puts it into
+// store, replacing the aggregates at the same times, so that importing them
+// twice does no harm. Nothing is rolled up, every tier is imported as it
+// was exported. It returns how many aggregates it read.
+func ImportAggregates(r io.Reader, store datastore.Store) (int, error) {
+	dec := json.NewDecoder(r)
+	var (
+		pending    map[time.Time]common.Status
+		series     string
+		resolution time.Duration
+	)
+	put := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		err := store.PutAggregates(series, resolution, pending)
+		if err != nil {
+			return fmt.Errorf("%s: %s", series, err.Error())
+		}
+		pending = nil
+		return nil
+	}
+	n := 0
+	for {
+		var a Aggregate
+		err := dec.Decode(&a)
+		if err == io.EOF {
+			return n, put()
+		}
+		if err != nil {
+			return n, fmt.Errorf("Failed to decode aggregate %d: %s", n+1, err.Error())
+		}
+		err = a.Series.Validate()
+		if err != nil {
+			return n, fmt.Errorf("Aggregate %d: %s", n+1, err.Error())
+		}
+		key := a.Series.Key()
+		if key != series || a.Resolution != resolution || len(pending) >= ImportFlushEvery {
+			if err = put(); err != nil {
+				return n, err
+			}
+			if key != series && a.Labels != nil {
+				if err = store.SetLabels(key, a.Labels); err != nil {
+					return n, fmt.Errorf("%s: %s", key, err.Error())
+				}
+			}
+			series, resolution = key, a.Resolution
+			pending = make(map[time.Time]common.Status)
+		}
+		pending[a.Time] = a.Status
+		n++
+	}
+}
@@ -0,0 +1,152 @@
+package export
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/datastore"
+)
+
+var testSeries = common.Series{Agent: "agent", Interface: "eth0", Probe: "icmp", Target: "192.0.2.1"}
+
+// importAll imports data into store through a Cache and flushes it, like
+// "checker import" does, and returns how many results it skipped.
+func importAll(t *testing.T, store datastore.Store, data []byte) int {
+	t.Helper()
+	cache, err := datastore.NewCache(store, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cache.Close()
+	dec, err := NewDecoder(bytes.NewReader(data), "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, skipped, err := Import(dec, store, func(r common.Result) error {
+		return cache.Write(r.Series.Key(), r.Response)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cache.FlushBefore(time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	return skipped
+}
+
+func TestImportSkipsStoredSeconds(t *testing.T) {
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	var b bytes.Buffer
+	enc, _ := NewEncoder(&b, "csv")
+	// Two results of each of three seconds
+	for i := 0; i < 6; i++ {
+		r := common.Response{IsUp: true, Latency: time.Millisecond, Time: start.Add(time.Duration(i/2) * time.Second)}
+		if err := enc.Encode(common.Result{Series: testSeries, Response: r}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	store := datastore.NewMemory()
+	for i, want := range []int{0, 6} {
+		if skipped := importAll(t, store, b.Bytes()); skipped != want {
+			t.Errorf("Import %d skipped %d results, want %d", i+1, skipped, want)
+		}
+	}
+	status, err := store.Query(testSeries.Key(), start, start.Add(time.Minute), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for at, s := range status {
+		if s.Count != 2 {
+			t.Errorf("Expected 2 results at %s, got %d", at, s.Count)
+		}
+	}
+	if len(status) != 3 {
+		t.Errorf("Expected 3 seconds, got %d", len(status))
+	}
+}
+
+func TestAggregatesRoundTrip(t *testing.T) {
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	from := datastore.NewMemory()
+	key := testSeries.Key()
+	from.SetLabels(key, map[string]string{"site": "home"})
+	err := from.WriteAggregate(key, map[time.Time]common.Status{
+		start:                       {Count: 1, Uptime: 100, Mean: 0.01},
+		start.Add(90 * time.Second): {Count: 2, Failures: 1, Uptime: 50, Mean: 0.02},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	n, err := ExportAggregates(&b, from, nil, start.Add(-time.Hour), start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Two seconds, two minutes, an hour and a day
+	if n != 6 {
+		t.Errorf("Expected 6 aggregates, got %d", n)
+	}
+	to := datastore.NewMemory()
+	// Importing twice replaces what the first import put
+	for i := 0; i < 2; i++ {
+		if _, err = ImportAggregates(bytes.NewReader(b.Bytes()), to); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, resolution := range datastore.Resolutions() {
+		want, _ := from.Query(key, start.Add(-24*time.Hour), start.Add(24*time.Hour), resolution)
+		got, err := to.Query(key, start.Add(-24*time.Hour), start.Add(24*time.Hour), resolution)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Imported %v at %s, want %v", got, resolution, want)
+		}
+	}
+	if labels, _ := to.Labels(key); labels["site"] != "home" {
+		t.Errorf("Expected labels to be imported, got %v", labels)
+	}
+}
+
+func TestInfluxRoundTrip(t *testing.T) {
+	at := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	store := datastore.NewMemory()
+	key := testSeries.Key()
+	store.SetLabels(key, map[string]string{"site": "home\noffice", "room": `a\nb`})
+	err := store.WriteSample(key, common.Response{Latency: time.Millisecond, Time: at,
+		Error: "connection reset\r\nby peer", Fields: map[string]float64{"ttfb": 0.5, "loss": math.NaN(), "jitter": math.Inf(1)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	enc, _ := NewEncoder(&b, "influx")
+	// A range of a single instant includes the sample at it
+	n, err := Export(enc, store, nil, at, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("Expected 1 sample, got %d", n)
+	}
+	if lines := strings.Count(b.String(), "\n"); lines != 1 {
+		t.Errorf("Expected a single line, got %q", b.String())
+	}
+	dec, _ := NewDecoder(&b, "influx")
+	r, err := dec.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := common.Result{Series: testSeries, Labels: map[string]string{"site": "home\noffice", "room": `a\nb`},
+		Response: common.Response{Latency: time.Millisecond, Time: at, Error: "connection reset\r\nby peer", Fields: map[string]float64{"ttfb": 0.5}}}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("Decoded %+v, want %+v", r, want)
+	}
+}
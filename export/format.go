@@ -0,0 +1,438 @@
+// Package export writes the raw samples and the aggregates of series in
+// portable formats and reads them back, without losing anything on the way.
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+// Formats lists the names of the supported formats.
+var Formats = []string{"csv", "jsonl", "influx"}
+
+// ContentType returns the MIME type of format.
+func ContentType(format string) string {
+	switch format {
+	case "csv":
+		return "text/csv"
+	case "jsonl":
+		return "application/x-ndjson"
+	}
+	return "text/plain"
+}
+
+// Encoder writes results one at a time.
+type Encoder interface {
+	Encode(r common.Result) error
+	// Flush writes out anything buffered.
+	Flush() error
+}
+
+// Decoder reads results one at a time and returns io.EOF after the last.
+type Decoder interface {
+	Decode() (common.Result, error)
+}
+
+func NewEncoder(w io.Writer, format string) (Encoder, error) {
+	switch format {
+	case "csv":
+		return &csvEncoder{w: csv.NewWriter(w)}, nil
+	case "jsonl":
+		bw := bufio.NewWriter(w)
+		return &jsonEncoder{bw, json.NewEncoder(bw)}, nil
+	case "influx":
+		return &influxEncoder{bufio.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("Unknown format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+func NewDecoder(r io.Reader, format string) (Decoder, error) {
+	switch format {
+	case "csv":
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = len(csvHeader)
+		cr.ReuseRecord = true
+		return &csvDecoder{r: cr}, nil
+	case "jsonl":
+		return &jsonDecoder{json.NewDecoder(r)}, nil
+	case "influx":
+		s := bufio.NewScanner(r)
+		s.Buffer(make([]byte, 64*1024), 1024*1024)
+		return &influxDecoder{s: s}, nil
+	}
+	return nil, fmt.Errorf("Unknown format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+// JSON Lines: one common.Result as JSON per line.
+
+type jsonEncoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (e *jsonEncoder) Encode(r common.Result) error { return e.enc.Encode(r) }
+func (e *jsonEncoder) Flush() error                 { return e.w.Flush() }
+
+type jsonDecoder struct {
+	dec *json.Decoder
+}
+
+func (d *jsonDecoder) Decode() (common.Result, error) {
+	var r common.Result
+	err := d.dec.Decode(&r)
+	return r, err
+}
+
+// CSV: a header and one sample per row. Times are RFC3339 with nanoseconds,
+// latencies are nanoseconds, and fields and labels are URL query encoded.
+
+var csvHeader = []string{"agent", "interface", "probe", "target", "time", "up", "latency", "error", "fields", "labels"}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvEncoder) Encode(r common.Result) error {
+	if !e.wroteHeader {
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	fields := make(url.Values)
+	for name, value := range r.Response.Fields {
+		fields.Set(name, strconv.FormatFloat(value, 'g', -1, 64))
+	}
+	labels := make(url.Values)
+	for name, value := range r.Labels {
+		labels.Set(name, value)
+	}
+	return e.w.Write([]string{
+		r.Series.Agent, r.Series.Interface, r.Series.Probe, r.Series.Target,
+		r.Response.Time.UTC().Format(time.RFC3339Nano),
+		strconv.FormatBool(r.Response.IsUp),
+		strconv.FormatInt(int64(r.Response.Latency), 10),
+		r.Response.Error,
+		fields.Encode(),
+		labels.Encode(),
+	})
+}
+
+func (e *csvEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type csvDecoder struct {
+	r          *csv.Reader
+	readHeader bool
+}
+
+func (d *csvDecoder) Decode() (common.Result, error) {
+	var r common.Result
+	if !d.readHeader {
+		header, err := d.r.Read()
+		if err != nil {
+			return r, err
+		}
+		if strings.Join(header, ",") != strings.Join(csvHeader, ",") {
+			return r, fmt.Errorf("Unexpected csv header, expected %s", strings.Join(csvHeader, ","))
+		}
+		d.readHeader = true
+	}
+	row, err := d.r.Read()
+	if err != nil {
+		return r, err
+	}
+	line, _ := d.r.FieldPos(0)
+	r.Series = common.Series{Agent: row[0], Interface: row[1], Probe: row[2], Target: row[3]}
+	r.Response.Time, err = time.Parse(time.RFC3339Nano, row[4])
+	if err != nil {
+		return r, fmt.Errorf("line %d: %s", line, err.Error())
+	}
+	r.Response.IsUp, err = strconv.ParseBool(row[5])
+	if err != nil {
+		return r, fmt.Errorf("line %d: %s", line, err.Error())
+	}
+	latency, err := strconv.ParseInt(row[6], 10, 64)
+	if err != nil {
+		return r, fmt.Errorf("line %d: %s", line, err.Error())
+	}
+	r.Response.Latency = time.Duration(latency)
+	r.Response.Error = row[7]
+	fields, err := url.ParseQuery(row[8])
+	if err != nil {
+		return r, fmt.Errorf("line %d: %s", line, err.Error())
+	}
+	for name := range fields {
+		if r.Response.Fields == nil {
+			r.Response.Fields = make(map[string]float64)
+		}
+		r.Response.Fields[name], err = strconv.ParseFloat(fields.Get(name), 64)
+		if err != nil {
+			return r, fmt.Errorf("line %d: %s", line, err.Error())
+		}
+	}
+	labels, err := url.ParseQuery(row[9])
+	if err != nil {
+		return r, fmt.Errorf("line %d: %s", line, err.Error())
+	}
+	for name := range labels {
+		if r.Labels == nil {
+			r.Labels = make(map[string]string)
+		}
+		r.Labels[name] = labels.Get(name)
+	}
+	return r, nil
+}
+
+// InfluxDB line protocol: one point of the measurement "checker" per
+// sample. The series and the labels are tags, an empty interface is left
+// out. The fields are up, latency in nanoseconds, error if there was one and
+// the probe specific fields. Labels named like a series tag and probe
+// fields named like one of the others can not be told apart and are left
+// out, as are fields that are NaN or infinite, which the protocol has no
+// values for. Newlines and carriage returns are escaped like quotes, as \n
+// and \r, so that every point stays on its line.
+
+const measurement = "checker"
+
+var seriesTags = []string{"agent", "interface", "probe", "target"}
+
+var reservedFields = map[string]bool{"up": true, "latency": true, "error": true}
+
+var (
+	keyEscaper    = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\n`, "\r", `\r`)
+	stringEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`, "\n", `\n`, "\r", `\r`)
+)
+
+type influxEncoder struct {
+	w *bufio.Writer
+}
+
+func (e *influxEncoder) Encode(r common.Result) error {
+	b := make([]byte, 0, 256)
+	b = append(b, measurement...)
+	for i, value := range []string{r.Series.Agent, r.Series.Interface, r.Series.Probe, r.Series.Target} {
+		if value == "" {
+			continue
+		}
+		b = append(b, ',')
+		b = append(b, seriesTags[i]...)
+		b = append(b, '=')
+		b = append(b, keyEscaper.Replace(value)...)
+	}
+	for _, name := range sortedKeys(r.Labels) {
+		if isSeriesTag(name) || name == "" || r.Labels[name] == "" {
+			continue
+		}
+		b = append(b, ',')
+		b = append(b, keyEscaper.Replace(name)...)
+		b = append(b, '=')
+		b = append(b, keyEscaper.Replace(r.Labels[name])...)
+	}
+	b = append(b, " up="...)
+	b = strconv.AppendBool(b, r.Response.IsUp)
+	b = append(b, ",latency="...)
+	b = strconv.AppendInt(b, int64(r.Response.Latency), 10)
+	b = append(b, 'i')
+	if r.Response.Error != "" {
+		b = append(b, `,error="`...)
+		b = append(b, stringEscaper.Replace(r.Response.Error)...)
+		b = append(b, '"')
+	}
+	fields := make([]string, 0, len(r.Response.Fields))
+	for name := range r.Response.Fields {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	for _, name := range fields {
+		value := r.Response.Fields[name]
+		if reservedFields[name] || math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		b = append(b, ',')
+		b = append(b, keyEscaper.Replace(name)...)
+		b = append(b, '=')
+		b = strconv.AppendFloat(b, value, 'g', -1, 64)
+	}
+	b = append(b, ' ')
+	b = strconv.AppendInt(b, r.Response.Time.UnixNano(), 10)
+	b = append(b, '\n')
+	_, err := e.w.Write(b)
+	return err
+}
+
+func (e *influxEncoder) Flush() error { return e.w.Flush() }
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isSeriesTag(name string) bool {
+	for _, tag := range seriesTags {
+		if name == tag {
+			return true
+		}
+	}
+	return false
+}
+
+type influxDecoder struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func (d *influxDecoder) Decode() (common.Result, error) {
+	for d.s.Scan() {
+		d.line++
+		line := strings.TrimSpace(d.s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		r, err := parseLine(line)
+		if err != nil {
+			return r, fmt.Errorf("line %d: %s", d.line, err.Error())
+		}
+		return r, nil
+	}
+	if err := d.s.Err(); err != nil {
+		return common.Result{}, err
+	}
+	return common.Result{}, io.EOF
+}
+
+func parseLine(line string) (common.Result, error) {
+	var r common.Result
+	// Quotes only delimit string field values, they are literal in tags
+	head := split(line, ' ', false)[0]
+	if len(head) == len(line) {
+		return r, fmt.Errorf("expected measurement and tags, fields and a timestamp")
+	}
+	parts := split(line[len(head)+1:], ' ', true)
+	if len(parts) != 2 {
+		return r, fmt.Errorf("expected measurement and tags, fields and a timestamp")
+	}
+	tags := split(head, ',', false)
+	if unescape(tags[0]) != measurement {
+		return r, fmt.Errorf("unexpected measurement %q", tags[0])
+	}
+	for _, tag := range tags[1:] {
+		kv := split(tag, '=', false)
+		if len(kv) != 2 {
+			return r, fmt.Errorf("invalid tag %q", tag)
+		}
+		name, value := unescape(kv[0]), unescape(kv[1])
+		switch name {
+		case "agent":
+			r.Series.Agent = value
+		case "interface":
+			r.Series.Interface = value
+		case "probe":
+			r.Series.Probe = value
+		case "target":
+			r.Series.Target = value
+		default:
+			if r.Labels == nil {
+				r.Labels = make(map[string]string)
+			}
+			r.Labels[name] = value
+		}
+	}
+	var err error
+	for _, field := range split(parts[0], ',', true) {
+		kv := split(field, '=', true)
+		if len(kv) != 2 {
+			return r, fmt.Errorf("invalid field %q", field)
+		}
+		name, value := unescape(kv[0]), kv[1]
+		switch {
+		case name == "up":
+			r.Response.IsUp, err = strconv.ParseBool(value)
+		case name == "latency":
+			var latency int64
+			latency, err = strconv.ParseInt(strings.TrimSuffix(value, "i"), 10, 64)
+			r.Response.Latency = time.Duration(latency)
+		case name == "error":
+			if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+				return r, fmt.Errorf("error is not a string")
+			}
+			r.Response.Error = unescape(value[1 : len(value)-1])
+		default:
+			if r.Response.Fields == nil {
+				r.Response.Fields = make(map[string]float64)
+			}
+			r.Response.Fields[name], err = strconv.ParseFloat(strings.TrimSuffix(value, "i"), 64)
+		}
+		if err != nil {
+			return r, fmt.Errorf("invalid field %q: %s", name, err.Error())
+		}
+	}
+	ns, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return r, fmt.Errorf("invalid timestamp: %s", err.Error())
+	}
+	r.Response.Time = time.Unix(0, ns).UTC()
+	return r, nil
+}
+
+// split splits s at every sep that is not escaped by a backslash, nor
+// inside double quotes if quotes is set. Escapes are kept.
+func split(s string, sep byte, quotes bool) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '"' && quotes:
+			quoted = !quoted
+		case s[i] == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescape removes the backslashes that escape the character after them,
+// turning \n and \r back into newlines and carriage returns.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n':
+				b = append(b, '\n')
+				continue
+			case 'r':
+				b = append(b, '\r')
+				continue
+			}
+		}
+		b = append(b, s[i])
+	}
+	return string(b)
+}
@@ -0,0 +1,235 @@
+package incident
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/datastore"
+)
+
+// Detector turns the results of series into incidents. A series goes down
+// once enough consecutive results failed within the configured window, and
+// up again after enough consecutive results succeeded, or once it sent no
+// results for the configured silence. The series of an agent that are down
+// at the same time share an incident, which ends when the last of them is
+// up again.
+type Detector struct {
+	mu         sync.Mutex
+	store      datastore.Store
+	failures   int
+	window     time.Duration
+	recoveries int
+	silence    time.Duration
+	started    time.Time
+	series     map[string]*state
+	open       map[string]*openIncident // by agent
+}
+
+// state is what the detector knows about a series.
+type state struct {
+	last      time.Time // time of the latest result
+	failures  []failure // the current run of failures within the window
+	down      bool
+	successes int       // consecutive successes while down
+	recovered time.Time // time of the first of them
+}
+
+type failure struct {
+	time time.Time
+	err  string
+}
+
+// openIncident is an ongoing incident along with the time its series
+// started to recover, which becomes its end once all of them are up, and
+// whether it has failures that were not stored yet.
+type openIncident struct {
+	common.Incident
+	recovered time.Time
+	unsaved   bool
+}
+
+// New returns a detector that stores incidents in store, picking up the
+// incidents that were ongoing when the server stopped.
+func New(store datastore.Store, c config.Incidents) (*Detector, error) {
+	d := &Detector{
+		store:      store,
+		failures:   c.Failures,
+		window:     c.Window.Duration,
+		recoveries: c.Recoveries,
+		silence:    c.Silence.Duration,
+		series:     make(map[string]*state),
+		open:       make(map[string]*openIncident),
+	}
+	now := time.Now()
+	d.started = now
+	incidents, err := store.Incidents(now, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range incidents {
+		if !i.Ongoing() {
+			continue
+		}
+		d.open[i.Agent] = &openIncident{Incident: i}
+		for _, key := range i.Series {
+			d.series[key] = &state{down: true}
+		}
+	}
+	return d, nil
+}
+
+// Observe feeds results to the detector. Results older than the latest one
+// of their series are ignored.
+func (d *Detector) Observe(results ...common.Result) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range results {
+		key := r.Series.Key()
+		s, ok := d.series[key]
+		if !ok {
+			s = &state{}
+			d.series[key] = s
+		}
+		t := r.Response.Time
+		if !t.After(s.last) {
+			continue
+		}
+		s.last = t
+		if r.Response.IsUp {
+			d.succeeded(r.Series, s, t)
+		} else {
+			d.failed(r.Series, s, t, r.Response.Error)
+		}
+	}
+}
+
+func (d *Detector) failed(series common.Series, s *state, t time.Time, err string) {
+	s.successes = 0
+	if s.down {
+		// Stored by the next Expire, rather than once per result
+		if i := d.open[series.Agent]; i != nil {
+			i.Failures++
+			i.unsaved = true
+		}
+		return
+	}
+	s.failures = append(s.failures, failure{t, err})
+	for len(s.failures) > 0 && t.Sub(s.failures[0].time) > d.window {
+		s.failures = s.failures[1:]
+	}
+	if len(s.failures) < d.failures {
+		return
+	}
+	s.down = true
+	first := s.failures[0]
+	n := len(s.failures)
+	s.failures = nil
+	i := d.open[series.Agent]
+	if i == nil {
+		i = &openIncident{Incident: common.Incident{Agent: series.Agent, Start: first.time, FirstError: first.err}}
+		d.open[series.Agent] = i
+	} else if first.time.Before(i.Start) {
+		i.Start, i.FirstError = first.time, first.err
+	}
+	i.Failures += n
+	key := series.Key()
+	if !contains(i.Series, key) {
+		i.Series = append(i.Series, key)
+		sort.Strings(i.Series)
+	}
+	d.save(i)
+}
+
+func (d *Detector) succeeded(series common.Series, s *state, t time.Time) {
+	s.failures = nil
+	if !s.down {
+		return
+	}
+	s.successes++
+	if s.successes == 1 {
+		s.recovered = t
+	}
+	if s.successes < d.recoveries {
+		return
+	}
+	s.down = false
+	s.successes = 0
+	i := d.open[series.Agent]
+	if i == nil {
+		return
+	}
+	if s.recovered.After(i.recovered) {
+		i.recovered = s.recovered
+	}
+	for _, key := range i.Series {
+		if d.series[key].down {
+			return
+		}
+	}
+	d.close(i)
+}
+
+// Expire takes series that are down but sent no results for the silence
+// before now as up again, as of their latest result, closing the incidents
+// all of whose series are up. Series restored by New count as seen when
+// the detector started. It also stores the failures of ongoing incidents.
+func (d *Detector) Expire(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, i := range d.open {
+		up := true
+		for _, key := range i.Series {
+			s := d.series[key]
+			if !s.down {
+				continue
+			}
+			last := s.last
+			if last.IsZero() {
+				last = d.started
+			}
+			if now.Sub(last) < d.silence {
+				up = false
+				continue
+			}
+			s.down = false
+			s.successes = 0
+			if last.After(i.recovered) {
+				i.recovered = last
+			}
+		}
+		if up {
+			d.close(i)
+		} else if i.unsaved {
+			d.save(i)
+		}
+	}
+}
+
+// close ends an incident once all of its series are up.
+func (d *Detector) close(i *openIncident) {
+	i.End = i.recovered
+	delete(d.open, i.Agent)
+	d.save(i)
+}
+
+func (d *Detector) save(i *openIncident) {
+	err := d.store.PutIncident(&i.Incident)
+	if err != nil {
+		log.Printf("Failed to store incident of %s: %s\n", i.Agent, err.Error())
+		return
+	}
+	i.unsaved = false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package incident
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/datastore"
+)
+
+var (
+	wlan = common.Series{Agent: "laptop", Interface: "wlan0", Probe: "icmp", Target: "192.168.1.1"}
+	eth  = common.Series{Agent: "laptop", Interface: "eth0", Probe: "icmp", Target: "192.168.1.1"}
+)
+
+var testConfig = config.Incidents{
+	Failures:   3,
+	Window:     config.Duration{Duration: 10 * time.Second},
+	Recoveries: 2,
+	Silence:    config.Duration{Duration: time.Minute},
+}
+
+// observe feeds the detector a result of series per second from start, up
+// for every true in ups, and returns the time after the last one.
+func observe(d *Detector, series common.Series, start time.Time, ups ...bool) time.Time {
+	for _, up := range ups {
+		r := common.Response{IsUp: up, Time: start}
+		if !up {
+			r.Error = "timeout"
+		}
+		d.Observe(common.Result{Series: series, Response: r})
+		start = start.Add(time.Second)
+	}
+	return start
+}
+
+// stored returns the only incident in store.
+func stored(t *testing.T, store datastore.Store) common.Incident {
+	t.Helper()
+	incidents, err := store.Incidents(time.Unix(0, 0), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(incidents) != 1 {
+		t.Fatalf("Expected 1 incident, got %+v", incidents)
+	}
+	return incidents[0]
+}
+
+func TestDetectorOpensAndCloses(t *testing.T) {
+	store := datastore.NewMemory()
+	d, err := New(store, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().UTC().Add(-time.Hour)
+	// Two failures are not enough, and a success ends their run
+	next := observe(d, wlan, start, false, false, true)
+	if incidents, _ := store.Incidents(time.Unix(0, 0), time.Now()); len(incidents) != 0 {
+		t.Fatalf("Expected no incident yet, got %+v", incidents)
+	}
+	down := next
+	next = observe(d, wlan, next, false, false, false)
+	next = observe(d, eth, next, false, false, false)
+	i := stored(t, store)
+	if !i.Ongoing() || !i.Start.Equal(down) || i.Failures != 6 || len(i.Series) != 2 || i.FirstError != "timeout" {
+		t.Errorf("Expected an ongoing incident of both series since %s, got %+v", down, i)
+	}
+	next = observe(d, wlan, next, true, true)
+	if i = stored(t, store); !i.Ongoing() {
+		t.Errorf("Expected the incident to go on while eth0 is down, got %+v", i)
+	}
+	up := next
+	observe(d, eth, next, true, true)
+	if i = stored(t, store); i.Ongoing() || !i.End.Equal(up) {
+		t.Errorf("Expected the incident to end at %s, got %+v", up, i)
+	}
+}
+
+func TestDetectorRestoresAndStoresFailures(t *testing.T) {
+	store := datastore.NewMemory()
+	start := time.Now().UTC().Add(-time.Hour)
+	err := store.PutIncident(&common.Incident{Agent: "laptop", Start: start, Series: []string{wlan.Key()}, FirstError: "timeout", Failures: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := New(store, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := observe(d, wlan, start.Add(time.Minute), false, false)
+	d.Expire(next)
+	if i := stored(t, store); !i.Ongoing() || i.Failures != 5 {
+		t.Errorf("Expected the restored incident to go on with 5 failures, got %+v", i)
+	}
+	// A restarted detector carries on with the stored count
+	d, err = New(store, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next = observe(d, wlan, next, false, true, true)
+	if i := stored(t, store); i.Ongoing() || i.Failures != 6 {
+		t.Errorf("Expected the incident to end with 6 failures, got %+v", i)
+	}
+}
+
+func TestDetectorClosesSilentIncidents(t *testing.T) {
+	store := datastore.NewMemory()
+	start := time.Now().UTC().Add(-time.Hour)
+	err := store.PutIncident(&common.Incident{Agent: "laptop", Start: start, Series: []string{wlan.Key()}, Failures: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := New(store, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A series that went down after the restart, and then silent
+	last := observe(d, eth, start, false, false, false).Add(-time.Second)
+	d.Expire(d.started.Add(testConfig.Silence.Duration / 2))
+	if i := stored(t, store); !i.Ongoing() {
+		t.Fatalf("Expected the incident to go on before the silence passed, got %+v", i)
+	}
+	d.Expire(d.started.Add(testConfig.Silence.Duration))
+	// The restored series was last seen when the detector started, after
+	// the latest result of the other one
+	if i := stored(t, store); i.Ongoing() || !i.End.Equal(d.started) {
+		t.Errorf("Expected the incident to end at %s, got %+v", d.started, i)
+	}
+	// Results after the silence open a new incident
+	observe(d, eth, last.Add(time.Second), false, false, false)
+	incidents, err := store.Incidents(time.Unix(0, 0), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(incidents) != 2 || !incidents[1].Ongoing() {
+		t.Errorf("Expected a second, ongoing incident, got %+v", incidents)
+	}
+}
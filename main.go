@@ -1,14 +1,24 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/alexgear/checker/alert"
 	"github.com/alexgear/checker/api"
 	"github.com/alexgear/checker/config"
 	"github.com/alexgear/checker/datastore"
+	"github.com/alexgear/checker/incident"
 	"github.com/alexgear/checker/network"
+	"github.com/alexgear/checker/sink"
+	"github.com/alexgear/checker/stream"
 	"github.com/alexgear/checker/worker"
 )
 
@@ -26,24 +36,23 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	if server {
-		log.Println("Init DB...")
-		db, err := datastore.InitDB()
+	if flag.Arg(0) == "db" {
+		err = dbCommand(flag.Args()[1:])
 		if err != nil {
 			log.Fatal(err)
 		}
-		defer db.Close()
-		ticker := time.NewTicker(10 * time.Second)
-		go func() {
-			for _ = range ticker.C {
-				err = datastore.FlushCache()
-				if err != nil {
-					log.Fatal(err)
-				}
-			}
-		}()
-		log.Println("Dialing...")
-		err = api.InitServer()
+	} else if flag.Arg(0) == "export" {
+		err = exportCommand(flag.Args()[1:])
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else if flag.Arg(0) == "import" {
+		err = importCommand(flag.Args()[1:])
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else if server {
+		err = runServer()
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -54,7 +63,10 @@ func main() {
 			log.Fatal(err)
 		}
 		log.Println("Dialing...")
-		worker.InitWorker()
+		err = worker.InitWorker()
+		if err != nil {
+			log.Fatal(err)
+		}
 		for {
 			time.Sleep(60 * time.Second)
 		}
@@ -62,3 +74,109 @@ func main() {
 		log.Fatal("Either -agent or -server must be used.")
 	}
 }
+
+// runServer serves the api until it fails or the process is asked to stop
+// with SIGINT or SIGTERM. It then stops taking requests, waits for the
+// background jobs, flushes the cache and closes the store.
+func runServer() error {
+	log.Println("Init DB...")
+	store, err := datastore.Open(config.C.Storage, config.C.DBPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	cache, err := datastore.NewCache(store, config.C.WALPath)
+	if err != nil {
+		return err
+	}
+	defer cache.Close()
+	hub := stream.NewHub()
+	cache.OnFlush(hub.PublishAggregates)
+	sinks, err := sink.Open(config.C.Sinks)
+	if err != nil {
+		return err
+	}
+	defer sinks.Close()
+	detector, err := incident.New(store, config.C.Incidents)
+	if err != nil {
+		return err
+	}
+	engine, err := alert.New(store, config.C.Rules, config.C.RuleLookback.Duration)
+	if err != nil {
+		return err
+	}
+	engine.OnEvent(hub.PublishAlert)
+	// The background jobs run every interval until done is closed
+	done := make(chan struct{})
+	var jobs sync.WaitGroup
+	every := func(interval time.Duration, job func(now time.Time)) {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case now := <-ticker.C:
+					job(now)
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+	every(10*time.Second, func(time.Time) {
+		// What could not be flushed stays cached until the next tick
+		err := cache.Flush()
+		if err != nil {
+			log.Println("Failed to flush cache:", err.Error())
+		}
+	})
+	every(time.Minute, func(time.Time) {
+		err := datastore.Expire(store)
+		if err != nil {
+			log.Println(err)
+		}
+	})
+	every(time.Minute, func(now time.Time) {
+		detector.Expire(now.UTC())
+	})
+	every(config.C.RuleInterval.Duration, func(now time.Time) {
+		err := engine.Evaluate(now.UTC())
+		if err != nil {
+			log.Println(err)
+		}
+	})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	server := api.InitServer(store, cache, sinks, hub, detector, engine)
+	served := make(chan error, 1)
+	log.Println("listening on: ", server.Addr)
+	go func() {
+		served <- server.ListenAndServe()
+	}()
+	select {
+	case err = <-served:
+	case sig := <-signals:
+		log.Printf("Got %s, shutting down...\n", sig)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err = server.Shutdown(ctx)
+		cancel()
+		if err == nil {
+			err = <-served
+		}
+		if err == http.ErrServerClosed {
+			err = nil
+		}
+	}
+	close(done)
+	jobs.Wait()
+	// Store the failures of ongoing incidents since the last Expire
+	detector.Expire(time.Now().UTC())
+	// Responses of the last seconds stay in the wal and are replayed
+	flushErr := cache.Flush()
+	if flushErr != nil {
+		log.Println("Failed to flush cache:", flushErr.Error())
+	}
+	return err
+}
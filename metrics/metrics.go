@@ -0,0 +1,259 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+// Buckets are the upper bounds of the latency histogram in seconds. They
+// start lower than Prometheus' defaults, probes of the local network often
+// take less than a millisecond.
+var Buckets = []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// uptimeWindow is the span the uptime gauge covers, in minutes.
+const uptimeWindow = 60
+
+// minute counts the results of one minute of the uptime window.
+type minute struct {
+	start    int64 // unix minute
+	count    int
+	failures int
+}
+
+// seriesMetrics is what a Registry keeps of a series.
+type seriesMetrics struct {
+	labels    string // rendered label pairs, without braces
+	buckets   []uint64
+	sum       float64 // seconds
+	successes uint64
+	failures  map[string]uint64 // by error class
+	up        bool
+	lastSeen  time.Time
+	minutes   [uptimeWindow]minute
+}
+
+// Registry turns probe results into metrics in the Prometheus text format.
+// It is safe for concurrent use.
+type Registry struct {
+	mu     sync.Mutex
+	series map[string]*seriesMetrics
+}
+
+func NewRegistry() *Registry {
+	return &Registry{series: make(map[string]*seriesMetrics)}
+}
+
+// Observe adds a result to the metrics of its series.
+func (reg *Registry) Observe(r common.Result) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	key := r.Series.Key()
+	s, ok := reg.series[key]
+	if !ok {
+		s = &seriesMetrics{buckets: make([]uint64, len(Buckets)), failures: make(map[string]uint64)}
+		reg.series[key] = s
+	}
+	s.labels = labelPairs(r.Series, r.Labels)
+	resp := r.Response
+	if resp.IsUp {
+		s.successes++
+		latency := resp.Latency.Seconds()
+		s.sum += latency
+		for i, bound := range Buckets {
+			if latency <= bound {
+				s.buckets[i]++
+			}
+		}
+	} else {
+		class := resp.Error
+		if class == "" {
+			class = "unknown"
+		}
+		s.failures[class]++
+	}
+	// Spooled results arrive late, they must not look like fresh ones
+	if !resp.Time.Before(s.lastSeen) {
+		s.lastSeen = resp.Time
+		s.up = resp.IsUp
+	}
+	unix := resp.Time.Unix() / 60
+	m := &s.minutes[unix%uptimeWindow]
+	if m.start != unix {
+		if m.start > unix {
+			// Older than the window
+			return
+		}
+		*m = minute{start: unix}
+	}
+	m.count++
+	if !resp.IsUp {
+		m.failures++
+	}
+}
+
+// metric is one metric family of the exposition.
+type metric struct {
+	name, kind, help string
+	write            func(w *bufio.Writer, name string, s *seriesMetrics)
+}
+
+var families = []metric{
+	{"checker_probe_latency_seconds", "histogram", "Latency of the probes that succeeded.", writeHistogram},
+	{"checker_probe_success_total", "counter", "Probes that succeeded.",
+		func(w *bufio.Writer, name string, s *seriesMetrics) {
+			fmt.Fprintf(w, "%s{%s} %d\n", name, s.labels, s.successes)
+		}},
+	{"checker_probe_failures_total", "counter", "Probes that failed, by error class.",
+		func(w *bufio.Writer, name string, s *seriesMetrics) {
+			classes := make([]string, 0, len(s.failures))
+			for class := range s.failures {
+				classes = append(classes, class)
+			}
+			sort.Strings(classes)
+			for _, class := range classes {
+				fmt.Fprintf(w, "%s{%s,error=\"%s\"} %d\n", name, s.labels, escape(class), s.failures[class])
+			}
+		}},
+	{"checker_probe_up", "gauge", "Whether the latest probe succeeded.",
+		func(w *bufio.Writer, name string, s *seriesMetrics) {
+			up := 0
+			if s.up {
+				up = 1
+			}
+			fmt.Fprintf(w, "%s{%s} %d\n", name, s.labels, up)
+		}},
+	{"checker_probe_last_seen_timestamp_seconds", "gauge", "Time of the latest probe.",
+		func(w *bufio.Writer, name string, s *seriesMetrics) {
+			fmt.Fprintf(w, "%s{%s} %s\n", name, s.labels, formatFloat(float64(s.lastSeen.UnixNano())/1e9))
+		}},
+	{"checker_probe_uptime_ratio", "gauge", "Share of the probes of the last hour that succeeded.",
+		func(w *bufio.Writer, name string, s *seriesMetrics) {
+			now := time.Now().Unix() / 60
+			count, failures := 0, 0
+			for _, m := range s.minutes {
+				if m.start > now-uptimeWindow {
+					count += m.count
+					failures += m.failures
+				}
+			}
+			if count > 0 {
+				fmt.Fprintf(w, "%s{%s} %s\n", name, s.labels, formatFloat(float64(count-failures)/float64(count)))
+			}
+		}},
+}
+
+func writeHistogram(w *bufio.Writer, name string, s *seriesMetrics) {
+	for i, bound := range Buckets {
+		fmt.Fprintf(w, "%s_bucket{%s,le=\"%s\"} %d\n", name, s.labels, formatFloat(bound), s.buckets[i])
+	}
+	fmt.Fprintf(w, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, s.labels, s.successes)
+	fmt.Fprintf(w, "%s_sum{%s} %s\n", name, s.labels, formatFloat(s.sum))
+	fmt.Fprintf(w, "%s_count{%s} %d\n", name, s.labels, s.successes)
+}
+
+// Write writes the metrics of all series to w, sorted by series.
+func (reg *Registry) Write(w io.Writer) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	keys := make([]string, 0, len(reg.series))
+	for key := range reg.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind)
+		for _, key := range keys {
+			f.write(bw, f.name, reg.series[key])
+		}
+	}
+	return bw.Flush()
+}
+
+// ServeHTTP serves the metrics to Prometheus.
+func (reg *Registry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-type", "text/plain; version=0.0.4; charset=utf-8")
+	err := reg.Write(w)
+	if err != nil {
+		log.Println("Failed to write metrics:", err.Error())
+	}
+}
+
+// reservedLabels are set by the registry itself and win over probe labels
+// of the same name.
+var reservedLabels = map[string]bool{"agent": true, "interface": true, "probe": true, "target": true, "error": true, "le": true}
+
+// Label is a Prometheus label.
+type Label struct {
+	Name, Value string
+}
+
+// Labels returns the labels of a series in Prometheus: agent, interface,
+// probe and target, followed by the probe labels sorted by name. Probe
+// labels that are not valid Prometheus label names are left out.
+func Labels(series common.Series, labels map[string]string) []Label {
+	ls := []Label{
+		{"agent", series.Agent},
+		{"interface", series.Interface},
+		{"probe", series.Probe},
+		{"target", series.Target},
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		if !reservedLabels[name] && ValidName(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ls = append(ls, Label{name, labels[name]})
+	}
+	return ls
+}
+
+// labelPairs renders the labels of a series as Prometheus label pairs.
+func labelPairs(series common.Series, labels map[string]string) string {
+	ls := Labels(series, labels)
+	pairs := make([]string, len(ls))
+	for i, l := range ls {
+		pairs[i] = l.Name + `="` + escape(l.Value) + `"`
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ValidName reports whether name matches [a-zA-Z_][a-zA-Z0-9_]* and is not
+// one of the names Prometheus reserves for itself.
+func ValidName(name string) bool {
+	if name == "" || strings.HasPrefix(name, "__") {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	reg := NewRegistry()
+	series := common.Series{Agent: "laptop", Interface: "wlan0", Probe: "http", Target: `https://example.com/"q"`}
+	labels := map[string]string{"site": "home", "bad-name": "x", "agent": "other"}
+	now := time.Now().UTC()
+	for i, r := range []common.Response{
+		{IsUp: true, Latency: 3 * time.Millisecond},
+		{IsUp: true, Latency: 20 * time.Millisecond},
+		{Error: "timeout"},
+		{},
+		{IsUp: true, Latency: 2 * time.Millisecond},
+	} {
+		r.Time = now.Add(time.Duration(i-5) * time.Second)
+		reg.Observe(common.Result{Series: series, Labels: labels, Response: r})
+	}
+	var b bytes.Buffer
+	if err := reg.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	ls := `agent="laptop",interface="wlan0",probe="http",target="https://example.com/\"q\"",site="home"`
+	for _, want := range []string{
+		"# HELP checker_probe_latency_seconds Latency of the probes that succeeded.",
+		"# TYPE checker_probe_latency_seconds histogram",
+		`checker_probe_latency_seconds_bucket{` + ls + `,le="0.0025"} 1`,
+		`checker_probe_latency_seconds_bucket{` + ls + `,le="0.005"} 2`,
+		`checker_probe_latency_seconds_bucket{` + ls + `,le="0.025"} 3`,
+		`checker_probe_latency_seconds_bucket{` + ls + `,le="+Inf"} 3`,
+		`checker_probe_latency_seconds_sum{` + ls + `} 0.025`,
+		`checker_probe_latency_seconds_count{` + ls + `} 3`,
+		"# TYPE checker_probe_success_total counter",
+		`checker_probe_success_total{` + ls + `} 3`,
+		"# TYPE checker_probe_failures_total counter",
+		`checker_probe_failures_total{` + ls + `,error="timeout"} 1`,
+		`checker_probe_failures_total{` + ls + `,error="unknown"} 1`,
+		"# TYPE checker_probe_up gauge",
+		`checker_probe_up{` + ls + `} 1`,
+		"# TYPE checker_probe_last_seen_timestamp_seconds gauge",
+		"# TYPE checker_probe_uptime_ratio gauge",
+		`checker_probe_uptime_ratio{` + ls + `} 0.6`,
+	} {
+		if !strings.Contains(out, want+"\n") {
+			t.Errorf("Expected line %s in:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"bad-name", `agent="other"`} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Expected %s to be left out of:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestValidName(t *testing.T) {
+	for name, want := range map[string]bool{
+		"site": true, "_x": true, "a1": true,
+		"": false, "1a": false, "bad-name": false, "__reserved": false,
+	} {
+		if got := ValidName(name); got != want {
+			t.Errorf("ValidName(%q) = %t, want %t", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// dialer returns a net.Dialer whose sockets are bound to interface ief, so
+// that traffic leaves through it regardless of the routing table.
+func dialer(ief string, timeout time.Duration) *net.Dialer {
+	d := &net.Dialer{Timeout: timeout}
+	if ief != "" {
+		d.Control = bindToDevice(ief)
+	}
+	return d
+}
+
+// interfaceAddr returns the first address of interface ief in the requested
+// address family.
+func interfaceAddr(ief string, v6 bool) (net.IP, error) {
+	i, err := net.InterfaceByName(ief)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := i.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		ipnet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if (ipnet.IP.To4() == nil) == v6 {
+			return ipnet.IP, nil
+		}
+	}
+	return nil, fmt.Errorf("interface %s has no suitable address", ief)
+}
@@ -0,0 +1,29 @@
+package network
+
+import (
+	"os"
+	"syscall"
+)
+
+// bindToDevice binds sockets to interface ief with SO_BINDTODEVICE, which
+// needs CAP_NET_RAW on kernels older than 5.7.
+func bindToDevice(ief string) func(network, address string, c syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		var err error
+		cerr := c.Control(func(fd uintptr) {
+			err = bindFd(int(fd), ief)
+		})
+		if cerr != nil {
+			return cerr
+		}
+		return err
+	}
+}
+
+func bindFd(fd int, ief string) error {
+	err := syscall.SetsockoptString(fd, syscall.SOL_SOCKET, syscall.SO_BINDTODEVICE, ief)
+	if err != nil {
+		return os.NewSyscallError("setsockopt SO_BINDTODEVICE", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package network
+
+import (
+	"os"
+	"strings"
+	"syscall"
+)
+
+// bindToDevice binds sockets to the address of interface ief. Unlike
+// SO_BINDTODEVICE this only selects the source address, the operating
+// system still needs a route through ief for traffic to leave through it.
+func bindToDevice(ief string) func(network, address string, c syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		ip, err := interfaceAddr(ief, strings.HasSuffix(network, "6"))
+		if err != nil {
+			return err
+		}
+		var sa syscall.Sockaddr
+		if ip4 := ip.To4(); ip4 != nil {
+			sa4 := &syscall.SockaddrInet4{}
+			copy(sa4.Addr[:], ip4)
+			sa = sa4
+		} else {
+			sa6 := &syscall.SockaddrInet6{}
+			copy(sa6.Addr[:], ip)
+			sa = sa6
+		}
+		cerr := c.Control(func(fd uintptr) {
+			err = syscall.Bind(int(fd), sa)
+		})
+		if cerr != nil {
+			return cerr
+		}
+		if err != nil {
+			return os.NewSyscallError("bind", err)
+		}
+		return nil
+	}
+}
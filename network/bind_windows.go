@@ -0,0 +1,13 @@
+package network
+
+import (
+	"errors"
+	"syscall"
+)
+
+// bindToDevice is not implemented on Windows.
+func bindToDevice(ief string) func(network, address string, c syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		return errors.New("binding to an interface is not supported on windows")
+	}
+}
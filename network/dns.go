@@ -0,0 +1,383 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+func init() {
+	Register("dns", newDNSProber)
+}
+
+var dnsTypes = map[string]uint16{
+	"A":     1,
+	"NS":    2,
+	"CNAME": 5,
+	"SOA":   6,
+	"MX":    15,
+	"TXT":   16,
+	"AAAA":  28,
+}
+
+var dnsRcodes = []string{"NOERROR", "FORMERR", "SERVFAIL", "NXDOMAIN", "NOTIMP", "REFUSED"}
+
+func rcodeName(rcode int) string {
+	if rcode < len(dnsRcodes) {
+		return dnsRcodes[rcode]
+	}
+	return fmt.Sprintf("RCODE%d", rcode)
+}
+
+// dnsProber asks a resolver a single question and checks the answer.
+// The target is the resolver address, the port defaults to 53.
+type dnsProber struct {
+	name    string
+	target  string
+	server  string
+	proto   string   // "udp" or "tcp"
+	qname   string   // name to resolve
+	qtype   uint16   // record type to ask for
+	rcode   string   // expected rcode
+	expect  []string // answers that must be present, if any
+	ief     string
+	timeout time.Duration
+}
+
+func newDNSProber(c Config) (Prober, error) {
+	p := &dnsProber{name: c.Name, target: c.Target, ief: c.Interface, timeout: c.Timeout}
+	p.server = c.Target
+	if _, _, err := net.SplitHostPort(p.server); err != nil {
+		p.server = net.JoinHostPort(strings.Trim(p.server, "[]"), "53")
+	}
+	p.proto = c.Option("proto", "udp")
+	if p.proto != "udp" && p.proto != "tcp" {
+		return nil, fmt.Errorf("proto must be udp or tcp, got %q", p.proto)
+	}
+	p.qname = c.Option("name", "")
+	if p.qname == "" {
+		return nil, errors.New("option name is required")
+	}
+	if !strings.HasSuffix(p.qname, ".") {
+		p.qname += "."
+	}
+	qtype := strings.ToUpper(c.Option("type", "A"))
+	var ok bool
+	p.qtype, ok = dnsTypes[qtype]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported record type %q", qtype)
+	}
+	p.rcode = strings.ToUpper(c.Option("rcode", "NOERROR"))
+	if expect := c.Option("expect", ""); expect != "" {
+		for _, answer := range strings.Split(expect, ",") {
+			p.expect = append(p.expect, normalizeAnswer(strings.TrimSpace(answer), p.qtype))
+		}
+	}
+	return p, nil
+}
+
+func (p *dnsProber) Name() string   { return p.name }
+func (p *dnsProber) Target() string { return p.target }
+
+func (p *dnsProber) Probe(ctx context.Context) common.Response {
+	start := time.Now().UTC()
+	r := common.Response{Time: start}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	reply, err := p.exchange(ctx)
+	r.Latency = time.Since(start)
+	if err != nil {
+		log.Printf("Failed to query %s: %s\n", p.server, err.Error())
+		r.Error = Classify(err)
+		return r
+	}
+	r.Fields = map[string]float64{
+		"rcode":   float64(reply.rcode),
+		"answers": float64(len(reply.answers)),
+	}
+	if reply.truncated {
+		r.Fields["truncated"] = 1
+	}
+	if err = p.check(reply); err != nil {
+		log.Printf("Unexpected answer from %s: %s\n", p.server, err.Error())
+		r.Error = Classify(err)
+		return r
+	}
+	r.IsUp = true
+	return r
+}
+
+// check compares a reply with the expected rcode and answers.
+func (p *dnsProber) check(reply *dnsReply) error {
+	if name := rcodeName(reply.rcode); name != p.rcode {
+		return &assertionError{ErrRcode, fmt.Sprintf("expected %s, got %s", p.rcode, name)}
+	}
+	got := make(map[string]bool)
+	for _, answer := range reply.answers {
+		got[answer] = true
+	}
+	for _, want := range p.expect {
+		if !got[want] {
+			answers := append([]string(nil), reply.answers...)
+			sort.Strings(answers)
+			return &assertionError{ErrMatch, fmt.Sprintf("expected %s in answers %v", want, answers)}
+		}
+	}
+	return nil
+}
+
+// exchange asks the question over the configured protocol. A truncated
+// reply over udp is asked again over tcp, like resolvers do, and the reply
+// over tcp keeps the truncated flag.
+func (p *dnsProber) exchange(ctx context.Context) (*dnsReply, error) {
+	reply, err := p.exchangeOver(ctx, p.proto)
+	if err != nil || !reply.truncated || p.proto != "udp" {
+		return reply, err
+	}
+	reply, err = p.exchangeOver(ctx, "tcp")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retry truncated reply over tcp: %s", err.Error())
+	}
+	reply.truncated = true
+	return reply, nil
+}
+
+func (p *dnsProber) exchangeOver(ctx context.Context, proto string) (*dnsReply, error) {
+	id := uint16(rand.Intn(1 << 16))
+	query, err := packQuery(id, p.qname, p.qtype)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := dialer(p.ief, p.timeout).DialContext(ctx, proto, p.server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if proto == "tcp" {
+		return exchangeTCP(conn, id, query, p.qtype)
+	}
+	return exchangeUDP(conn, id, query, p.qtype)
+}
+
+// exchangeUDP reads datagrams until the reply to the query or the deadline
+// comes in. Datagrams that don't parse or answer another query are skipped,
+// anyone on the path can send them.
+func exchangeUDP(conn net.Conn, id uint16, query []byte, qtype uint16) (*dnsReply, error) {
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 4096)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		reply, err := parseReply(buf[:n], qtype)
+		if err == nil && reply.id == id {
+			return reply, nil
+		}
+	}
+}
+
+func exchangeTCP(conn net.Conn, id uint16, query []byte, qtype uint16) (*dnsReply, error) {
+	msg := make([]byte, 2+len(query))
+	binary.BigEndian.PutUint16(msg, uint16(len(query)))
+	copy(msg[2:], query)
+	if _, err := conn.Write(msg); err != nil {
+		return nil, err
+	}
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return nil, err
+	}
+	reply, err := parseReply(buf, qtype)
+	if err != nil {
+		return nil, err
+	}
+	if reply.id != id {
+		return nil, fmt.Errorf("reply id %d does not match query id %d", reply.id, id)
+	}
+	return reply, nil
+}
+
+// packQuery builds a recursive query for a single question of class IN.
+func packQuery(id uint16, qname string, qtype uint16) ([]byte, error) {
+	b := make([]byte, 12, 512)
+	binary.BigEndian.PutUint16(b[0:], id)
+	binary.BigEndian.PutUint16(b[2:], 0x0100) // RD
+	binary.BigEndian.PutUint16(b[4:], 1)      // QDCOUNT
+	for _, label := range strings.Split(strings.TrimSuffix(qname, "."), ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, fmt.Errorf("invalid name %q", qname)
+		}
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	b = append(b, 0)
+	b = append(b, byte(qtype>>8), byte(qtype), 0, 1)
+	return b, nil
+}
+
+// dnsReply is the part of a DNS response the probe cares about.
+type dnsReply struct {
+	id        uint16
+	rcode     int
+	truncated bool
+	answers   []string // rdata of answers matching the question type
+}
+
+var errShortReply = errors.New("dns reply too short")
+
+func parseReply(b []byte, qtype uint16) (*dnsReply, error) {
+	if len(b) < 12 {
+		return nil, errShortReply
+	}
+	flags := binary.BigEndian.Uint16(b[2:])
+	if flags&0x8000 == 0 {
+		return nil, errors.New("dns message is not a reply")
+	}
+	reply := &dnsReply{
+		id:        binary.BigEndian.Uint16(b[0:]),
+		rcode:     int(flags & 0xf),
+		truncated: flags&0x0200 != 0,
+	}
+	qdcount := int(binary.BigEndian.Uint16(b[4:]))
+	ancount := int(binary.BigEndian.Uint16(b[6:]))
+	off := 12
+	for i := 0; i < qdcount; i++ {
+		_, n, err := readName(b, off)
+		if err != nil {
+			return nil, err
+		}
+		off = n + 4
+	}
+	for i := 0; i < ancount; i++ {
+		_, n, err := readName(b, off)
+		if err != nil {
+			return nil, err
+		}
+		off = n
+		if off+10 > len(b) {
+			return nil, errShortReply
+		}
+		rtype := binary.BigEndian.Uint16(b[off:])
+		rdlength := int(binary.BigEndian.Uint16(b[off+8:]))
+		off += 10
+		if off+rdlength > len(b) {
+			return nil, errShortReply
+		}
+		if rtype == qtype {
+			answer, err := readRdata(b, off, rdlength, rtype)
+			if err != nil {
+				return nil, err
+			}
+			reply.answers = append(reply.answers, answer)
+		}
+		off += rdlength
+	}
+	return reply, nil
+}
+
+// readName reads a possibly compressed name starting at off and returns it
+// together with the offset right after it.
+func readName(b []byte, off int) (string, int, error) {
+	var labels []string
+	end := -1
+	for jumps := 0; ; {
+		if off >= len(b) {
+			return "", 0, errShortReply
+		}
+		length := int(b[off])
+		switch {
+		case length == 0:
+			if end < 0 {
+				end = off + 1
+			}
+			return strings.Join(labels, ".") + ".", end, nil
+		case length&0xc0 == 0xc0:
+			if off+1 >= len(b) {
+				return "", 0, errShortReply
+			}
+			if jumps++; jumps > 32 {
+				return "", 0, errors.New("dns name compression loop")
+			}
+			if end < 0 {
+				end = off + 2
+			}
+			off = int(binary.BigEndian.Uint16(b[off:]) & 0x3fff)
+		default:
+			if off+1+length > len(b) {
+				return "", 0, errShortReply
+			}
+			labels = append(labels, string(b[off+1:off+1+length]))
+			off += 1 + length
+		}
+	}
+}
+
+func readRdata(b []byte, off, length int, rtype uint16) (string, error) {
+	rdata := b[off : off+length]
+	switch rtype {
+	case dnsTypes["A"], dnsTypes["AAAA"]:
+		if len(rdata) != net.IPv4len && len(rdata) != net.IPv6len {
+			return "", errShortReply
+		}
+		return net.IP(rdata).String(), nil
+	case dnsTypes["NS"], dnsTypes["CNAME"]:
+		name, _, err := readName(b, off)
+		return strings.ToLower(name), err
+	case dnsTypes["MX"]:
+		if len(rdata) < 3 {
+			return "", errShortReply
+		}
+		name, _, err := readName(b, off+2)
+		return strings.ToLower(name), err
+	case dnsTypes["TXT"]:
+		var parts []string
+		for i := 0; i < len(rdata); {
+			n := int(rdata[i])
+			if i+1+n > len(rdata) {
+				return "", errShortReply
+			}
+			parts = append(parts, string(rdata[i+1:i+1+n]))
+			i += 1 + n
+		}
+		return strings.Join(parts, ""), nil
+	}
+	return fmt.Sprintf("%x", rdata), nil
+}
+
+// normalizeAnswer brings an expected answer into the form readRdata
+// produces for qtype, so "2001:DB8::1" matches "2001:db8::1" and
+// "Example.com" matches "example.com.".
+func normalizeAnswer(answer string, qtype uint16) string {
+	switch qtype {
+	case dnsTypes["A"], dnsTypes["AAAA"]:
+		if ip := net.ParseIP(answer); ip != nil {
+			return ip.String()
+		}
+	case dnsTypes["NS"], dnsTypes["CNAME"], dnsTypes["MX"]:
+		answer = strings.ToLower(answer)
+		if !strings.HasSuffix(answer, ".") {
+			answer += "."
+		}
+	}
+	return answer
+}
@@ -0,0 +1,205 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubDNS answers queries on 127.0.0.1 over UDP and TCP from a fixed zone:
+//
+//	example.test.  A 192.0.2.1 and 192.0.2.2
+//	big.test.      A 192.0.2.1 and 192.0.2.2, truncated over UDP
+//	noisy.test.    A 192.0.2.1 and 192.0.2.2, after junk and a reply with another ID over UDP
+//	broken.test.   SERVFAIL
+//	slow.test.     never answered
+//
+// Any other name is NXDOMAIN. UDP and TCP share a port.
+type stubDNS struct {
+	udp   net.PacketConn
+	tcp   net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func newStubDNS(t *testing.T) *stubDNS {
+	s := &stubDNS{}
+	var err error
+	s.udp, err = net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.tcp, err = net.Listen("tcp", s.udp.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.serveUDP()
+	go s.serveTCP()
+	t.Cleanup(s.close)
+	return s
+}
+
+func (s *stubDNS) close() {
+	s.udp.Close()
+	s.tcp.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.conns {
+		c.Close()
+	}
+}
+
+func (s *stubDNS) serveUDP() {
+	buf := make([]byte, 512)
+	for {
+		n, from, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		reply := answer(buf[:n], true)
+		if reply == nil {
+			continue
+		}
+		if name, _, _ := readName(buf[:n], 12); name == "noisy.test." {
+			s.udp.WriteTo([]byte("junk"), from)
+			other := append([]byte(nil), reply...)
+			other[0]++
+			s.udp.WriteTo(other, from)
+		}
+		s.udp.WriteTo(reply, from)
+	}
+}
+
+func (s *stubDNS) serveTCP() {
+	for {
+		conn, err := s.tcp.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		go func() {
+			var length [2]byte
+			if _, err := io.ReadFull(conn, length[:]); err != nil {
+				return
+			}
+			query := make([]byte, binary.BigEndian.Uint16(length[:]))
+			if _, err := io.ReadFull(conn, query); err != nil {
+				return
+			}
+			reply := answer(query, false)
+			if reply == nil {
+				return
+			}
+			binary.BigEndian.PutUint16(length[:], uint16(len(reply)))
+			conn.Write(append(length[:], reply...))
+		}()
+	}
+}
+
+// answer builds the reply to a query, nil if it should go unanswered.
+func answer(query []byte, udp bool) []byte {
+	name, end, err := readName(query, 12)
+	if err != nil || end+4 > len(query) {
+		return nil
+	}
+	var rcode uint16
+	var ips []net.IP
+	switch name {
+	case "example.test.", "big.test.", "noisy.test.":
+		ips = []net.IP{net.IPv4(192, 0, 2, 1), net.IPv4(192, 0, 2, 2)}
+	case "broken.test.":
+		rcode = 2
+	case "slow.test.":
+		return nil
+	default:
+		rcode = 3
+	}
+	reply := make([]byte, 12, 512)
+	copy(reply, query[:2])
+	flags := 0x8180 | rcode // QR, RD, RA
+	if udp && name == "big.test." {
+		flags |= 0x0200 // TC
+		ips = nil
+	}
+	binary.BigEndian.PutUint16(reply[2:], flags)
+	binary.BigEndian.PutUint16(reply[4:], 1)
+	binary.BigEndian.PutUint16(reply[6:], uint16(len(ips)))
+	reply = append(reply, query[12:end+4]...)
+	for _, ip := range ips {
+		// A pointer to the name of the question, type A, class IN, TTL 60
+		reply = append(reply, 0xc0, 12, 0, 1, 0, 1, 0, 0, 0, 60, 0, 4)
+		reply = append(reply, ip.To4()...)
+	}
+	return reply
+}
+
+func TestDNSProbe(t *testing.T) {
+	s := newStubDNS(t)
+	for _, tc := range []struct {
+		name    string
+		options map[string]string
+		up      bool
+		err     string
+		answers float64
+	}{
+		{"noerror", map[string]string{"name": "example.test"}, true, "", 2},
+		{"expected answer", map[string]string{"name": "example.test", "expect": "192.0.2.2"}, true, "", 2},
+		{"missing answer", map[string]string{"name": "example.test", "expect": "192.0.2.3"}, false, ErrMatch, 2},
+		{"nxdomain", map[string]string{"name": "missing.test"}, false, ErrRcode, 0},
+		{"expected nxdomain", map[string]string{"name": "missing.test", "rcode": "nxdomain"}, true, "", 0},
+		{"servfail", map[string]string{"name": "broken.test"}, false, ErrRcode, 0},
+		{"timeout", map[string]string{"name": "slow.test"}, false, ErrTimeout, 0},
+		{"truncated", map[string]string{"name": "big.test"}, true, "", 2},
+		{"stray datagrams", map[string]string{"name": "noisy.test"}, true, "", 2},
+	} {
+		for _, proto := range []string{"udp", "tcp"} {
+			t.Run(tc.name+" over "+proto, func(t *testing.T) {
+				target := s.udp.LocalAddr().String()
+				if proto == "tcp" {
+					target = s.tcp.Addr().String()
+				}
+				options := map[string]string{"proto": proto}
+				for k, v := range tc.options {
+					options[k] = v
+				}
+				p, err := New(Config{Type: "dns", Target: target, Timeout: 200 * time.Millisecond, Options: options})
+				if err != nil {
+					t.Fatal(err)
+				}
+				r := p.Probe(context.Background())
+				if r.IsUp != tc.up || r.Error != tc.err {
+					t.Fatalf("Expected up %v with error %q, got up %v with error %q", tc.up, tc.err, r.IsUp, r.Error)
+				}
+				if tc.err != ErrTimeout && r.Fields["answers"] != tc.answers {
+					t.Errorf("Expected %g answers, got %g", tc.answers, r.Fields["answers"])
+				}
+				if want := tc.name == "truncated" && proto == "udp"; (r.Fields["truncated"] == 1) != want {
+					t.Errorf("Expected truncated %v, got fields %v", want, r.Fields)
+				}
+			})
+		}
+	}
+}
+
+func TestDNSProbeRefused(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := l.Addr().String()
+	l.Close()
+	p, err := New(Config{Type: "dns", Target: target, Timeout: time.Second,
+		Options: map[string]string{"name": "example.test", "proto": "tcp"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := p.Probe(context.Background()); r.IsUp || r.Error != ErrRefused {
+		t.Errorf("Expected error %q, got up %v with error %q", ErrRefused, r.IsUp, r.Error)
+	}
+}
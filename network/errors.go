@@ -0,0 +1,65 @@
+package network
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"syscall"
+)
+
+// Error classes reported in common.Response.Error.
+const (
+	ErrTimeout     = "timeout"
+	ErrRefused     = "refused"
+	ErrUnreachable = "unreachable"
+	ErrDNS         = "dns"
+	ErrTLS         = "tls"
+	ErrStatus      = "status" // unexpected response status
+	ErrMatch       = "match"  // response did not match the expected content
+	ErrRcode       = "rcode"  // unexpected DNS response code
+	ErrOther       = "other"
+)
+
+// assertionError is returned by probes that got an answer from the target,
+// but not the expected one.
+type assertionError struct {
+	class string
+	msg   string
+}
+
+func (e *assertionError) Error() string { return e.msg }
+
+// Classify maps a probe error to one of the error classes.
+func Classify(err error) string {
+	if err == nil {
+		return ""
+	}
+	var assertErr *assertionError
+	if errors.As(err, &assertErr) {
+		return assertErr.class
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return ErrDNS
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrTimeout
+	}
+	var recordErr tls.RecordHeaderError
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &recordErr) || errors.As(err, &certErr) {
+		return ErrTLS
+	}
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ErrRefused
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return ErrUnreachable
+	}
+	return ErrOther
+}
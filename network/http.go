@@ -0,0 +1,188 @@
+package network
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+func init() {
+	Register("http", newHTTPProber)
+}
+
+// maxBodySize limits how much of a response body is read for matching.
+const maxBodySize = 1 << 20
+
+// httpProber fetches a URL over a fresh connection and reports how long each
+// phase of the request took, see common.HTTPPhases.
+type httpProber struct {
+	name     string
+	target   string
+	method   string
+	status   int            // expected status code, any 2xx or 3xx when 0
+	match    *regexp.Regexp // pattern the body must match, if set
+	insecure bool           // skip TLS certificate verification
+	proxy    *url.URL       // proxy to send requests through, none if nil
+	ief      string
+	timeout  time.Duration
+}
+
+func newHTTPProber(c Config) (Prober, error) {
+	p := &httpProber{name: c.Name, target: c.Target, ief: c.Interface, timeout: c.Timeout}
+	p.method = c.Option("method", http.MethodGet)
+	var err error
+	p.status, err = c.Int("status", 0)
+	if err != nil {
+		return nil, err
+	}
+	if pattern := c.Option("match", ""); pattern != "" {
+		p.match, err = regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to compile match: %s", err.Error())
+		}
+	}
+	p.insecure, err = strconv.ParseBool(c.Option("insecure", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse option insecure: %s", err.Error())
+	}
+	if proxy := c.Option("proxy", ""); proxy != "" {
+		p.proxy, err = url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse option proxy: %s", err.Error())
+		}
+	}
+	if _, err = http.NewRequest(p.method, p.target, nil); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *httpProber) Name() string   { return p.name }
+func (p *httpProber) Target() string { return p.target }
+
+// phaseTimer records the httptrace callbacks of a single request.
+type phaseTimer struct {
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	wroteRequest, firstByte   time.Time
+}
+
+func (t *phaseTimer) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { t.dnsStart = time.Now() },
+		DNSDone:              func(httptrace.DNSDoneInfo) { t.dnsDone = time.Now() },
+		ConnectStart:         func(string, string) { t.connectStart = time.Now() },
+		ConnectDone:          func(string, string, error) { t.connectDone = time.Now() },
+		TLSHandshakeStart:    func() { t.tlsStart = time.Now() },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { t.tlsDone = time.Now() },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { t.wroteRequest = time.Now() },
+		GotFirstResponseByte: func() { t.firstByte = time.Now() },
+	}
+}
+
+func span(from, to time.Time) float64 {
+	if from.IsZero() || to.IsZero() {
+		return 0
+	}
+	return to.Sub(from).Seconds()
+}
+
+func (t *phaseTimer) fields(start, end time.Time) map[string]float64 {
+	f := map[string]float64{
+		"dns":     span(t.dnsStart, t.dnsDone),
+		"connect": span(t.connectStart, t.connectDone),
+		"tls":     span(t.tlsStart, t.tlsDone),
+		"ttfb":    span(t.wroteRequest, t.firstByte),
+		"total":   end.Sub(start).Seconds(),
+	}
+	if !t.firstByte.IsZero() {
+		f["transfer"] = end.Sub(t.firstByte).Seconds()
+	}
+	return f
+}
+
+func (p *httpProber) Probe(ctx context.Context) common.Response {
+	start := time.Now().UTC()
+	r := common.Response{Time: start}
+	timer := &phaseTimer{}
+	ctx, cancel := context.WithTimeout(httptrace.WithClientTrace(ctx, timer.trace()), p.timeout)
+	defer cancel()
+	code, err := p.fetch(ctx)
+	end := time.Now()
+	r.Latency = end.Sub(start)
+	r.Fields = timer.fields(start, end)
+	if code != 0 {
+		r.Fields["status_code"] = float64(code)
+	}
+	if err != nil {
+		log.Printf("Failed to fetch %s: %s\n", p.target, err.Error())
+		r.Error = Classify(err)
+		return r
+	}
+	r.IsUp = true
+	return r
+}
+
+// fetch performs the request and checks the response against the
+// configured assertions. It returns the status code whenever a response was
+// received, even if an assertion failed.
+func (p *httpProber) fetch(ctx context.Context) (int, error) {
+	req, err := http.NewRequest(p.method, p.target, nil)
+	if err != nil {
+		return 0, err
+	}
+	// A proxy from the environment would measure the path to the proxy
+	// instead of the target, so requests only go through one configured
+	// for the probe
+	transport := &http.Transport{
+		DialContext:       dialer(p.ief, p.timeout).DialContext,
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: p.insecure},
+		DisableKeepAlives: true,
+	}
+	if p.proxy != nil {
+		transport.Proxy = http.ProxyURL(p.proxy)
+	}
+	defer transport.CloseIdleConnections()
+	client := http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	var body []byte
+	if p.match != nil {
+		body, err = ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	} else {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+	}
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if p.status != 0 && resp.StatusCode != p.status {
+		return resp.StatusCode, &assertionError{ErrStatus, fmt.Sprintf("expected status %d, got %d", p.status, resp.StatusCode)}
+	}
+	if p.status == 0 && resp.StatusCode >= 400 {
+		return resp.StatusCode, &assertionError{ErrStatus, fmt.Sprintf("got status %d", resp.StatusCode)}
+	}
+	if p.match != nil && !p.match.Match(body) {
+		return resp.StatusCode, &assertionError{ErrMatch, fmt.Sprintf("body does not match %q", p.match.String())}
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,39 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPProxy(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer target.Close()
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+	}))
+	defer proxy.Close()
+	for _, test := range []struct {
+		options map[string]string
+		target  string
+		want    int
+	}{
+		{nil, target.URL, 0},
+		{map[string]string{"proxy": proxy.URL}, "http://example.test/health", 1},
+	} {
+		p, err := New(Config{Type: "http", Target: test.target, Timeout: 2 * time.Second, Options: test.options})
+		if err != nil {
+			t.Fatal(err)
+		}
+		proxied = nil
+		if r := p.Probe(context.Background()); !r.IsUp {
+			t.Errorf("Probe of %s with %v failed: %s", test.target, test.options, r.Error)
+		}
+		if len(proxied) != test.want {
+			t.Errorf("Expected %d requests through the proxy probing %s, got %v", test.want, test.target, proxied)
+		}
+	}
+}
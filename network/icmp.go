@@ -0,0 +1,307 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+func init() {
+	Register("icmp", newICMPProber)
+}
+
+const (
+	icmpv4EchoRequest = 8
+	icmpv4EchoReply   = 0
+	icmpv6EchoRequest = 128
+	icmpv6EchoReply   = 129
+)
+
+// icmpProber sends a round of ICMP echo requests to the target and reports
+// round trip times and packet loss of the round.
+type icmpProber struct {
+	name     string
+	target   string
+	count    int           // echo requests per round
+	interval time.Duration // pause between echo requests
+	size     int           // payload size in bytes
+	wait     time.Duration // how long to wait for replies after the last request
+	ief      string
+	timeout  time.Duration
+}
+
+func newICMPProber(c Config) (Prober, error) {
+	p := &icmpProber{name: c.Name, target: c.Target, ief: c.Interface, timeout: c.Timeout}
+	var err error
+	p.count, err = c.Int("count", 5)
+	if err != nil {
+		return nil, err
+	}
+	if p.count < 1 {
+		return nil, fmt.Errorf("count must be positive, got %d", p.count)
+	}
+	p.interval, err = c.Duration("interval", 10*time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+	p.wait, err = c.Duration("wait", time.Second)
+	if err != nil {
+		return nil, err
+	}
+	p.size, err = c.Int("size", 56)
+	if err != nil {
+		return nil, err
+	}
+	if p.size < 0 || p.size > 65000 {
+		return nil, fmt.Errorf("size out of range: %d", p.size)
+	}
+	return p, nil
+}
+
+func (p *icmpProber) Name() string   { return p.name }
+func (p *icmpProber) Target() string { return p.target }
+
+func (p *icmpProber) Probe(ctx context.Context) common.Response {
+	start := time.Now().UTC()
+	r := common.Response{Time: start}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	stats, err := p.round(ctx)
+	r.Latency = time.Since(start)
+	if err != nil {
+		log.Printf("Failed to ping %s: %s\n", p.target, err.Error())
+		r.Error = Classify(err)
+		return r
+	}
+	r.Fields = stats.fields()
+	if stats.received == 0 {
+		r.Error = ErrTimeout
+		return r
+	}
+	r.IsUp = true
+	r.Latency = stats.avg()
+	return r
+}
+
+// echoStats collects the outcome of one round of echo requests.
+type echoStats struct {
+	sent       int
+	received   int // unique replies
+	duplicates int
+	outOfOrder int
+	min, max   time.Duration
+	total      time.Duration
+}
+
+func (s *echoStats) add(rtt time.Duration) {
+	if s.received == 0 || rtt < s.min {
+		s.min = rtt
+	}
+	if rtt > s.max {
+		s.max = rtt
+	}
+	s.total += rtt
+	s.received++
+}
+
+func (s *echoStats) avg() time.Duration {
+	if s.received == 0 {
+		return 0
+	}
+	return s.total / time.Duration(s.received)
+}
+
+func (s *echoStats) loss() float64 {
+	if s.sent == 0 {
+		return 0
+	}
+	return float64(s.sent-s.received) * 100 / float64(s.sent)
+}
+
+func (s *echoStats) fields() map[string]float64 {
+	return map[string]float64{
+		"sent":         float64(s.sent),
+		"received":     float64(s.received),
+		"loss":         s.loss(),
+		"duplicates":   float64(s.duplicates),
+		"out_of_order": float64(s.outOfOrder),
+		"rtt_min":      s.min.Seconds(),
+		"rtt_avg":      s.avg().Seconds(),
+		"rtt_max":      s.max.Seconds(),
+	}
+}
+
+func (p *icmpProber) round(ctx context.Context) (*echoStats, error) {
+	ip, err := resolveIP(ctx, p.target)
+	if err != nil {
+		return nil, err
+	}
+	v6 := ip.To4() == nil
+	conn, dst, raw, err := listenICMP(ip, p.ief)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	// Datagram sockets get their echo identifier assigned by the kernel and
+	// only see their own replies, raw sockets see every reply on the host.
+	id := uint16(rand.Intn(1 << 16))
+	request, reply := byte(icmpv4EchoRequest), byte(icmpv4EchoReply)
+	if v6 {
+		request, reply = icmpv6EchoRequest, icmpv6EchoReply
+	}
+
+	var mu sync.Mutex
+	sentAt := make([]time.Time, p.count)
+	stats := &echoStats{}
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(sendErr)
+		defer func() {
+			if wait := time.Now().Add(p.wait); wait.Before(deadline) {
+				conn.SetReadDeadline(wait)
+			}
+		}()
+		for seq := 0; seq < p.count; seq++ {
+			msg := marshalEcho(request, id, uint16(seq), p.size, !v6)
+			mu.Lock()
+			sentAt[seq] = time.Now()
+			stats.sent++
+			mu.Unlock()
+			if _, err := conn.WriteTo(msg, dst); err != nil {
+				sendErr <- err
+				return
+			}
+			if seq < p.count-1 {
+				select {
+				case <-time.After(p.interval):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	seen := make([]bool, p.count)
+	highest := -1
+	buf := make([]byte, p.size+128)
+	for {
+		mu.Lock()
+		done := stats.received == p.count
+		mu.Unlock()
+		if done {
+			break
+		}
+		n, from, err := conn.ReadFrom(buf)
+		now := time.Now()
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				break
+			}
+			return nil, err
+		}
+		typ, rid, seq, ok := parseEcho(buf[:n])
+		if !ok || typ != reply || int(seq) >= p.count {
+			continue
+		}
+		if raw && (rid != id || !sameIP(from, ip)) {
+			continue
+		}
+		mu.Lock()
+		if sentAt[seq].IsZero() {
+			mu.Unlock()
+			continue
+		}
+		if seen[seq] {
+			stats.duplicates++
+		} else {
+			seen[seq] = true
+			if int(seq) < highest {
+				stats.outOfOrder++
+			} else {
+				highest = int(seq)
+			}
+			stats.add(now.Sub(sentAt[seq]))
+		}
+		mu.Unlock()
+	}
+	if err := <-sendErr; err != nil && stats.received == 0 {
+		return nil, err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return stats, nil
+}
+
+// marshalEcho builds an echo request. The kernel fills in the checksum of
+// ICMPv6 messages, ICMPv4 ones are summed here.
+func marshalEcho(typ byte, id, seq uint16, size int, checksum bool) []byte {
+	b := make([]byte, 8+size)
+	b[0] = typ
+	binary.BigEndian.PutUint16(b[4:], id)
+	binary.BigEndian.PutUint16(b[6:], seq)
+	for i := 8; i < len(b); i++ {
+		b[i] = byte(i)
+	}
+	if checksum {
+		binary.BigEndian.PutUint16(b[2:], icmpChecksum(b))
+	}
+	return b
+}
+
+func parseEcho(b []byte) (typ byte, id, seq uint16, ok bool) {
+	if len(b) < 8 {
+		return 0, 0, 0, false
+	}
+	return b[0], binary.BigEndian.Uint16(b[4:]), binary.BigEndian.Uint16(b[6:]), true
+}
+
+func icmpChecksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum&0xffff + sum>>16
+	}
+	return ^uint16(sum)
+}
+
+func sameIP(addr net.Addr, ip net.IP) bool {
+	switch a := addr.(type) {
+	case *net.IPAddr:
+		return a.IP.Equal(ip)
+	case *net.UDPAddr:
+		return a.IP.Equal(ip)
+	}
+	return false
+}
+
+// resolveIP resolves host to its first address.
+func resolveIP(ctx context.Context, host string) (net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip, nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, &net.DNSError{Err: "no addresses", Name: host}
+	}
+	return addrs[0].IP, nil
+}
@@ -0,0 +1,43 @@
+package network
+
+import (
+	"context"
+	"net"
+	"os"
+	"syscall"
+)
+
+// listenICMP opens an ICMP socket suitable for pinging ip. It prefers
+// unprivileged datagram sockets (see net.ipv4.ping_group_range) and falls
+// back to raw sockets, which need root or CAP_NET_RAW. raw reports which
+// kind of socket was opened. The socket is bound to interface ief, if set.
+func listenICMP(ip net.IP, ief string) (conn net.PacketConn, dst net.Addr, raw bool, err error) {
+	family, proto, network := syscall.AF_INET, syscall.IPPROTO_ICMP, "ip4:icmp"
+	if ip.To4() == nil {
+		family, proto, network = syscall.AF_INET6, syscall.IPPROTO_ICMPV6, "ip6:ipv6-icmp"
+	}
+	fd, err := syscall.Socket(family, syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, proto)
+	if err == nil && ief != "" {
+		if err = bindFd(fd, ief); err != nil {
+			syscall.Close(fd)
+			return nil, nil, false, err
+		}
+	}
+	if err == nil {
+		f := os.NewFile(uintptr(fd), "icmp")
+		conn, err = net.FilePacketConn(f)
+		f.Close()
+		if err == nil {
+			return conn, &net.UDPAddr{IP: ip}, false, nil
+		}
+	}
+	lc := net.ListenConfig{}
+	if ief != "" {
+		lc.Control = bindToDevice(ief)
+	}
+	conn, err = lc.ListenPacket(context.Background(), network, "")
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return conn, &net.IPAddr{IP: ip}, true, nil
+}
@@ -0,0 +1,28 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"context"
+	"net"
+)
+
+// listenICMP opens a raw ICMP socket suitable for pinging ip, which needs
+// elevated privileges on most systems. The socket is bound to interface ief,
+// if set.
+func listenICMP(ip net.IP, ief string) (conn net.PacketConn, dst net.Addr, raw bool, err error) {
+	network := "ip4:icmp"
+	if ip.To4() == nil {
+		network = "ip6:ipv6-icmp"
+	}
+	lc := net.ListenConfig{}
+	if ief != "" {
+		lc.Control = bindToDevice(ief)
+	}
+	conn, err = lc.ListenPacket(context.Background(), network, "")
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return conn, &net.IPAddr{IP: ip}, true, nil
+}
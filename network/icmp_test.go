@@ -0,0 +1,52 @@
+package network
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestICMPProbeLoopback(t *testing.T) {
+	conn, _, _, err := listenICMP(net.ParseIP("127.0.0.1"), "")
+	if err != nil {
+		t.Skipf("Neither datagram nor raw ICMP sockets are allowed: %s", err.Error())
+	}
+	conn.Close()
+
+	p, err := New(Config{
+		Name:    "loopback",
+		Type:    "icmp",
+		Target:  "127.0.0.1",
+		Timeout: 5 * time.Second,
+		Options: map[string]string{"count": "3", "interval": "1ms", "wait": "500ms"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := p.Probe(context.Background())
+	if !r.IsUp {
+		t.Fatalf("Expected 127.0.0.1 to be up, got error %q", r.Error)
+	}
+	if r.Fields["sent"] != 3 || r.Fields["received"] != 3 {
+		t.Errorf("Expected 3 echo requests and replies, got %v", r.Fields)
+	}
+	if r.Fields["loss"] != 0 {
+		t.Errorf("Expected no loss, got %g%%", r.Fields["loss"])
+	}
+	if r.Latency <= 0 || r.Fields["rtt_min"] > r.Fields["rtt_max"] {
+		t.Errorf("Unexpected round trip times: latency %s, fields %v", r.Latency, r.Fields)
+	}
+}
+
+func TestICMPChecksum(t *testing.T) {
+	msg := marshalEcho(icmpv4EchoRequest, 0x1234, 7, 13, true)
+	// A message that carries its checksum sums to zero
+	if sum := icmpChecksum(msg); sum != 0 {
+		t.Errorf("Expected checksum of marshalled echo to verify, got %#04x", sum)
+	}
+	typ, id, seq, ok := parseEcho(msg)
+	if !ok || typ != icmpv4EchoRequest || id != 0x1234 || seq != 7 {
+		t.Errorf("Unexpected echo: type %d id %#x seq %d ok %v", typ, id, seq, ok)
+	}
+}
@@ -0,0 +1,106 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+// Prober measures a single target. Probe must return within the deadline of
+// ctx and report failures through Response.Error rather than panicking.
+type Prober interface {
+	Name() string
+	Target() string
+	Probe(ctx context.Context) common.Response
+}
+
+// Config holds everything needed to build a Prober of any type.
+type Config struct {
+	Name      string        // series name the results are reported under
+	Type      string        // registered probe type, e.g. "tcp"
+	Target    string        // probe specific target, e.g. "8.8.8.8:53"
+	Interface string        // network interface to send probes through, any if empty
+	Timeout   time.Duration // upper bound for a single probe
+
+	// Options holds probe specific settings, e.g. "count" for icmp.
+	Options map[string]string
+}
+
+// Int returns the integer option key, or def when it is not set.
+func (c Config) Int(key string, def int) (int, error) {
+	v, ok := c.Options[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to parse option %s: %s", key, err.Error())
+	}
+	return i, nil
+}
+
+// Duration returns the duration option key, or def when it is not set.
+func (c Config) Duration(key string, def time.Duration) (time.Duration, error) {
+	v, ok := c.Options[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to parse option %s: %s", key, err.Error())
+	}
+	return d, nil
+}
+
+// Option returns the string option key, or def when it is not set.
+func (c Config) Option(key string, def string) string {
+	v, ok := c.Options[key]
+	if !ok || v == "" {
+		return def
+	}
+	return v
+}
+
+// Factory builds a Prober from its config.
+type Factory func(c Config) (Prober, error)
+
+var registry = make(map[string]Factory)
+
+// Register makes a probe type available to New. It is meant to be called
+// from init functions and panics if the type is registered twice.
+func Register(kind string, f Factory) {
+	if _, ok := registry[kind]; ok {
+		panic(fmt.Sprintf("network: probe type %q registered twice", kind))
+	}
+	registry[kind] = f
+}
+
+// New builds a Prober of type c.Type.
+func New(c Config) (Prober, error) {
+	f, ok := registry[c.Type]
+	if !ok {
+		return nil, fmt.Errorf("Unknown probe type %q, known types are %v", c.Type, Types())
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 5 * time.Second
+	}
+	p, err := f(c)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create %s probe %q: %s", c.Type, c.Name, err.Error())
+	}
+	return p, nil
+}
+
+// Types returns the names of all registered probe types.
+func Types() []string {
+	var types []string
+	for kind := range registry {
+		types = append(types, kind)
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,46 @@
+package network
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+func init() {
+	Register("tcp", newTCPProber)
+}
+
+// tcpProber considers a target up when a TCP handshake with it succeeds.
+type tcpProber struct {
+	name    string
+	target  string
+	ief     string
+	timeout time.Duration
+}
+
+func newTCPProber(c Config) (Prober, error) {
+	if _, _, err := net.SplitHostPort(c.Target); err != nil {
+		return nil, err
+	}
+	return &tcpProber{name: c.Name, target: c.Target, ief: c.Interface, timeout: c.Timeout}, nil
+}
+
+func (p *tcpProber) Name() string   { return p.name }
+func (p *tcpProber) Target() string { return p.target }
+
+func (p *tcpProber) Probe(ctx context.Context) common.Response {
+	start := time.Now().UTC()
+	conn, err := dialer(p.ief, p.timeout).DialContext(ctx, "tcp", p.target)
+	r := common.Response{Latency: time.Since(start), Time: start}
+	if err != nil {
+		log.Printf("Failed to initiate tcp connection: %s\n", err.Error())
+		r.Error = Classify(err)
+		return r
+	}
+	defer conn.Close()
+	r.IsUp = true
+	return r
+}
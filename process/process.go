@@ -1,43 +1,234 @@
 package process
 
 import (
-	"fmt"
+	"errors"
+	"math"
 	"time"
 
 	"github.com/alexgear/checker/common"
-	"github.com/montanaflynn/stats"
+	"github.com/alexgear/checker/sketch"
 )
 
-var err error
-
+// Compute aggregates the aggregates of a time range into one. Percentiles
+// are exact up to sketch.Accuracy as long as every aggregate has a sketch.
 func Compute(status map[time.Time]common.Status) (common.Status, error) {
-	var mean []float64
-	var percentile90 []float64
-	var percentile99 []float64
-	var uptime []float64
-	for _, s := range status {
-		mean = append(mean, s.Mean)
-		percentile90 = append(percentile90, s.Percentile90)
-		percentile99 = append(percentile99, s.Percentile99)
-		uptime = append(uptime, s.Uptime)
-	}
-	// Calculating statistics
-	var s common.Status
-	s.Mean, err = stats.Mean(mean)
-	if err != nil {
-		return s, fmt.Errorf("Failed to calculate mean: %s", err.Error())
-	}
-	s.Percentile90, err = stats.Mean(percentile90)
-	if err != nil {
-		return s, fmt.Errorf("Failed to calculate mean: %s", err.Error())
-	}
-	s.Percentile99, err = stats.Mean(percentile99)
-	if err != nil {
-		return s, fmt.Errorf("Failed to calculate mean: %s", err.Error())
-	}
-	s.Uptime, err = stats.Mean(uptime)
-	if err != nil {
-		return s, fmt.Errorf("Failed to calculate mean: %s", err.Error())
+	if len(status) == 0 {
+		return common.Status{}, errors.New("Failed to calculate statistics: no data")
+	}
+	var a accumulator
+	for _, st := range status {
+		a.add(st)
+	}
+	s := a.status()
+	if len(s.PhaseSketches) > 0 {
+		s.Phases = make(map[string]common.Percentiles, len(s.PhaseSketches))
+		for name, phase := range s.PhaseSketches {
+			s.Phases[name] = percentiles(phase)
+		}
 	}
 	return s, nil
 }
+
+// accumulator merges aggregates one by one like Merge does, but merges
+// their sketches in place. The sketches of the first aggregate are only
+// copied once a second one comes in, so that the sketches of the
+// aggregates added are never modified.
+type accumulator struct {
+	s        common.Status // without sketches
+	sketch   *sketch.Sketch
+	phases   map[string]*sketch.Sketch
+	complete bool // whether every aggregate added had a sketch
+	owned    bool // whether sketch and phases are copies
+	n        int
+}
+
+func (a *accumulator) add(st common.Status) {
+	if a.n == 0 {
+		a.sketch, a.phases, a.complete = st.Sketch, st.PhaseSketches, st.Sketch != nil
+	} else {
+		if !a.owned {
+			a.own()
+		}
+		if a.complete && st.Sketch != nil {
+			a.sketch.Merge(st.Sketch)
+		} else {
+			a.sketch, a.complete = nil, false
+		}
+		for name, phase := range st.PhaseSketches {
+			if a.phases[name] == nil {
+				a.phases[name] = phase.Copy()
+			} else {
+				a.phases[name].Merge(phase)
+			}
+		}
+	}
+	st.Sketch = nil
+	st.PhaseSketches = nil
+	a.s = Merge(a.s, st)
+	a.n++
+}
+
+// own replaces the sketches of the first aggregate by copies.
+func (a *accumulator) own() {
+	if a.sketch != nil {
+		a.sketch = a.sketch.Copy()
+	}
+	phases := make(map[string]*sketch.Sketch, len(a.phases))
+	for name, phase := range a.phases {
+		phases[name] = phase.Copy()
+	}
+	a.phases = phases
+	a.owned = true
+}
+
+// status returns the merged aggregate. The accumulator must not be added
+// to afterwards.
+func (a *accumulator) status() common.Status {
+	s := a.s
+	if a.complete {
+		s.Sketch = a.sketch
+		// A single aggregate keeps its own, exact percentiles
+		if a.n > 1 {
+			setPercentiles(&s, a.sketch)
+		}
+	}
+	if len(a.phases) > 0 {
+		s.PhaseSketches = a.phases
+	}
+	return s
+}
+
+// percentiles returns the percentiles of the samples of a sketch.
+func percentiles(sk *sketch.Sketch) common.Percentiles {
+	return common.Percentiles{
+		Percentile50:  sk.Quantile(0.50),
+		Percentile90:  sk.Quantile(0.90),
+		Percentile95:  sk.Quantile(0.95),
+		Percentile99:  sk.Quantile(0.99),
+		Percentile999: sk.Quantile(0.999),
+	}
+}
+
+// setPercentiles sets the percentiles of s from the sketch of its samples.
+func setPercentiles(s *common.Status, sk *sketch.Sketch) {
+	p := percentiles(sk)
+	s.Percentile50 = p.Percentile50
+	s.Percentile90 = p.Percentile90
+	s.Percentile95 = p.Percentile95
+	s.Percentile99 = p.Percentile99
+	s.Percentile999 = p.Percentile999
+}
+
+// weight returns the number of samples behind s. Aggregates written before
+// the count was recorded are weighted as a single sample.
+func weight(s common.Status) float64 {
+	if s.Count == 0 {
+		return 1
+	}
+	return float64(s.Count)
+}
+
+// Merge combines the aggregates of two disjoint sets of samples.
+func Merge(a, b common.Status) common.Status {
+	if a.Count == 0 && a.Mean == 0 && a.Uptime == 0 {
+		return b
+	}
+	if b.Count == 0 && b.Mean == 0 && b.Uptime == 0 {
+		return a
+	}
+	wa, wb := weight(a), weight(b)
+	avg := func(x, y float64) float64 {
+		return (x*wa + y*wb) / (wa + wb)
+	}
+	s := common.Status{Count: a.Count + b.Count, Failures: a.Failures + b.Failures}
+	s.Uptime = avg(a.Uptime, b.Uptime)
+	s.Mean = avg(a.Mean, b.Mean)
+	// Pooled variance of both sets: each set's variance plus the squared
+	// distance of its mean from the combined mean
+	da, db := a.Mean-s.Mean, b.Mean-s.Mean
+	s.StandardDeviation = math.Sqrt(avg(
+		a.StandardDeviation*a.StandardDeviation+da*da,
+		b.StandardDeviation*b.StandardDeviation+db*db,
+	))
+	// Aggregates written before min and max were recorded have a max of 0
+	switch {
+	case a.Max == 0:
+		s.Min, s.Max = b.Min, b.Max
+	case b.Max == 0:
+		s.Min, s.Max = a.Min, a.Max
+	default:
+		s.Min, s.Max = math.Min(a.Min, b.Min), math.Max(a.Max, b.Max)
+	}
+	if a.Sketch != nil && b.Sketch != nil {
+		s.Sketch = a.Sketch.Copy()
+		s.Sketch.Merge(b.Sketch)
+		setPercentiles(&s, s.Sketch)
+	} else {
+		// Without sketches percentiles can not be merged, weight them
+		// like the mean
+		s.Percentile50 = avg(a.Percentile50, b.Percentile50)
+		s.Percentile90 = avg(a.Percentile90, b.Percentile90)
+		s.Percentile95 = avg(a.Percentile95, b.Percentile95)
+		s.Percentile99 = avg(a.Percentile99, b.Percentile99)
+		s.Percentile999 = avg(a.Percentile999, b.Percentile999)
+	}
+	// A phase is missing from the aggregates of seconds the probe did not
+	// report it in, the sketch of the other holds all of its samples
+	for name, phase := range a.PhaseSketches {
+		if s.PhaseSketches == nil {
+			s.PhaseSketches = make(map[string]*sketch.Sketch)
+		}
+		s.PhaseSketches[name] = phase.Copy()
+		if other, ok := b.PhaseSketches[name]; ok {
+			s.PhaseSketches[name].Merge(other)
+		}
+	}
+	for name, phase := range b.PhaseSketches {
+		if _, ok := a.PhaseSketches[name]; ok {
+			continue
+		}
+		if s.PhaseSketches == nil {
+			s.PhaseSketches = make(map[string]*sketch.Sketch)
+		}
+		s.PhaseSketches[name] = phase.Copy()
+	}
+	for name, value := range a.Fields {
+		if s.Fields == nil {
+			s.Fields = make(map[string]float64)
+		}
+		s.Fields[name] = value
+		if other, ok := b.Fields[name]; ok {
+			s.Fields[name] = avg(value, other)
+		}
+	}
+	for name, value := range b.Fields {
+		if _, ok := a.Fields[name]; ok {
+			continue
+		}
+		if s.Fields == nil {
+			s.Fields = make(map[string]float64)
+		}
+		s.Fields[name] = value
+	}
+	return s
+}
+
+// Downsample merges aggregates into buckets of the given resolution, each
+// keyed by the time it starts at.
+func Downsample(status map[time.Time]common.Status, resolution time.Duration) map[time.Time]common.Status {
+	accumulators := make(map[time.Time]*accumulator)
+	for t, s := range status {
+		start := t.UTC().Truncate(resolution)
+		a := accumulators[start]
+		if a == nil {
+			a = &accumulator{}
+			accumulators[start] = a
+		}
+		a.add(s)
+	}
+	buckets := make(map[time.Time]common.Status, len(accumulators))
+	for start, a := range accumulators {
+		buckets[start] = a.status()
+	}
+	return buckets
+}
@@ -0,0 +1,64 @@
+package process
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/sketch"
+)
+
+// seconds returns n per-second aggregates of one sample each, starting at
+// start, with a sketch and a phase sketch.
+func seconds(start time.Time, n int) map[time.Time]common.Status {
+	status := make(map[time.Time]common.Status, n)
+	for i := 0; i < n; i++ {
+		latency := float64(i%10+1) / 1000
+		s := common.Status{Count: 1, Uptime: 100, Mean: latency, Min: latency, Max: latency,
+			Sketch: sketch.New(), PhaseSketches: map[string]*sketch.Sketch{"ttfb": sketch.New()}}
+		s.Sketch.Add(latency)
+		s.PhaseSketches["ttfb"].Add(latency / 2)
+		status[start.Add(time.Duration(i)*time.Second)] = s
+	}
+	return status
+}
+
+func TestDownsampleMatchesMerge(t *testing.T) {
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	status := seconds(start, 150)
+	buckets := Downsample(status, time.Minute)
+	if len(buckets) != 3 {
+		t.Fatalf("Expected 3 buckets, got %d", len(buckets))
+	}
+	for bucket, got := range buckets {
+		var want common.Status
+		for ts, s := range status {
+			if ts.Truncate(time.Minute).Equal(bucket) {
+				want = Merge(want, s)
+			}
+		}
+		if got.Count != want.Count || math.Abs(got.Mean-want.Mean) > 1e-12 ||
+			got.Percentile99 != want.Percentile99 || got.Max != want.Max {
+			t.Errorf("Downsampled %+v at %s, want %+v", got, bucket, want)
+		}
+		if got.Sketch.Count() != uint64(want.Count) || got.PhaseSketches["ttfb"].Count() != uint64(want.Count) {
+			t.Errorf("Expected sketches of %d samples at %s", want.Count, bucket)
+		}
+	}
+	// The sketches of the input are left alone
+	for ts, s := range status {
+		if s.Sketch.Count() != 1 || s.PhaseSketches["ttfb"].Count() != 1 {
+			t.Fatalf("Downsample modified the sketches at %s", ts)
+		}
+	}
+}
+
+func BenchmarkDownsample(b *testing.B) {
+	status := seconds(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), 3600)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Downsample(status, time.Hour)
+	}
+}
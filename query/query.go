@@ -0,0 +1,259 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/datastore"
+	"github.com/alexgear/checker/process"
+)
+
+// MaxPoints bounds the number of points per series a query may return.
+const MaxPoints = 100000
+
+// stats maps the names of the statistics a query can return to the field
+// of the aggregate that holds them. Probe specific measurements are named
+// "field.<name>".
+var stats = map[string]func(common.Status) float64{
+	"count":    func(s common.Status) float64 { return float64(s.Count) },
+	"failures": func(s common.Status) float64 { return float64(s.Failures) },
+	"uptime":   func(s common.Status) float64 { return s.Uptime },
+	"mean":     func(s common.Status) float64 { return s.Mean },
+	"stddev":   func(s common.Status) float64 { return s.StandardDeviation },
+	"min":      func(s common.Status) float64 { return s.Min },
+	"max":      func(s common.Status) float64 { return s.Max },
+	"p50":      func(s common.Status) float64 { return s.Percentile50 },
+	"p90":      func(s common.Status) float64 { return s.Percentile90 },
+	"p95":      func(s common.Status) float64 { return s.Percentile95 },
+	"p99":      func(s common.Status) float64 { return s.Percentile99 },
+	"p999":     func(s common.Status) float64 { return s.Percentile999 },
+}
+
+// DefaultStats are returned when a query does not name any.
+var DefaultStats = []string{"mean", "p50", "p90", "p95", "p99", "p999", "min", "max", "count", "failures"}
+
+// ParseStats parses a comma separated list of statistics.
+func ParseStats(s string) ([]string, error) {
+	if s == "" {
+		return DefaultStats, nil
+	}
+	names := strings.Split(s, ",")
+	for _, name := range names {
+		if _, ok := stats[name]; !ok && !strings.HasPrefix(name, "field.") {
+			return nil, fmt.Errorf("Unknown stat %q", name)
+		}
+	}
+	return names, nil
+}
+
+// ParseDuration is time.ParseDuration that also takes days and weeks, like
+// "7d" or "1w12h". Durations have no sign, times before or after now are
+// written like "now-1h" instead.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("Invalid duration %q", s)
+	}
+	if strings.ContainsAny(s, "+-") {
+		return 0, fmt.Errorf("Invalid duration %q, durations have no sign", s)
+	}
+	var d time.Duration
+	for _, unit := range []struct {
+		suffix string
+		length time.Duration
+	}{{"w", 7 * 24 * time.Hour}, {"d", 24 * time.Hour}} {
+		i := strings.Index(s, unit.suffix)
+		if i < 0 {
+			continue
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("Invalid duration %q", s)
+		}
+		d += time.Duration(n) * unit.length
+		s = s[i+1:]
+	}
+	if s == "" {
+		return d, nil
+	}
+	rest, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	return d + rest, nil
+}
+
+// ParseTime parses s as an RFC3339 time, "now", a time relative to now like
+// "now-7d" or "now+1h", or a duration before now like "24h". A bare "-1h" is
+// neither and rejected.
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	if s == "now" {
+		return now, nil
+	}
+	if strings.HasPrefix(s, "now-") || strings.HasPrefix(s, "now+") {
+		d, err := ParseDuration(s[4:])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("Invalid time %q: %s", s, err.Error())
+		}
+		if s[3] == '-' {
+			d = -d
+		}
+		return now.Add(d), nil
+	}
+	if d, err := ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return t, fmt.Errorf("Invalid time %q, expected RFC3339, now-7d or a duration like 24h", s)
+	}
+	return t, nil
+}
+
+// Request selects the statistics of some series at a resolution of Step.
+type Request struct {
+	Series []string // all series if empty
+	From   time.Time
+	To     time.Time
+	Step   time.Duration
+	Stats  []string
+}
+
+// Point is the time a bucket starts at and the value of every requested
+// statistic in it, nil where the bucket has no value for it. It is encoded
+// as a JSON array, the time first.
+type Point struct {
+	Time   time.Time
+	Values []*float64
+}
+
+func (p Point) MarshalJSON() ([]byte, error) {
+	row := make([]interface{}, 0, len(p.Values)+1)
+	row = append(row, p.Time.Format(time.RFC3339Nano))
+	for _, v := range p.Values {
+		row = append(row, v)
+	}
+	return json.Marshal(row)
+}
+
+// Series is the result of a query for one series, its points sorted by
+// time.
+type Series struct {
+	Series string            `json:"series"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Points []Point           `json:"points"`
+}
+
+// Result is what Run returns, and what /v1/query responds with.
+type Result struct {
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+	Step   float64   `json:"step"`  // Seconds
+	Stats  []string  `json:"stats"` // the order of the values of each point
+	Series []Series  `json:"series"`
+}
+
+// Validate checks that the request can be run.
+func (req Request) Validate() error {
+	if req.Step < time.Second {
+		return fmt.Errorf("Step must be at least 1s")
+	}
+	if !req.From.Before(req.To) {
+		return fmt.Errorf("From must be before to")
+	}
+	if n := req.To.Sub(req.From) / req.Step; n > MaxPoints {
+		return fmt.Errorf("Query spans %d steps, at most %d are allowed", n, MaxPoints)
+	}
+	return nil
+}
+
+// Run reads the aggregates of the requested series from store and merges
+// them into buckets of req.Step.
+func Run(store datastore.Store, req Request) (*Result, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	series := req.Series
+	if len(series) == 0 {
+		series, err = store.ListSeries()
+		if err != nil {
+			return nil, err
+		}
+	}
+	result := &Result{
+		From:   req.From,
+		To:     req.To,
+		Step:   req.Step.Seconds(),
+		Stats:  req.Stats,
+		Series: make([]Series, 0, len(series)),
+	}
+	for _, key := range series {
+		buckets, err := Read(store, key, req)
+		if err != nil {
+			return nil, err
+		}
+		labels, err := store.Labels(key)
+		if err != nil {
+			return nil, err
+		}
+		times := make([]time.Time, 0, len(buckets))
+		for t := range buckets {
+			times = append(times, t)
+		}
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+		s := Series{Series: key, Labels: labels, Points: make([]Point, len(times))}
+		for i, t := range times {
+			s.Points[i] = Point{Time: t, Values: values(buckets[t], req.Stats)}
+		}
+		result.Series = append(result.Series, s)
+	}
+	return result, nil
+}
+
+// Read returns the aggregates of one series that Run reads for req, merged
+// into buckets of req.Step. req.Series is ignored.
+func Read(store datastore.Store, series string, req Request) (map[time.Time]common.Status, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	status, err := store.Query(series, req.From, req.To, req.Step)
+	if err != nil {
+		return nil, err
+	}
+	return process.Downsample(status, req.Step), nil
+}
+
+func values(s common.Status, names []string) []*float64 {
+	vs := make([]*float64, len(names))
+	for i, name := range names {
+		if v, ok := Value(s, name); ok {
+			vs[i] = &v
+		}
+	}
+	return vs
+}
+
+// Value returns the statistic name of an aggregate. It reports false if the
+// aggregate has no such value, or it is not a finite number, which JSON
+// can't encode.
+func Value(s common.Status, name string) (float64, bool) {
+	var v float64
+	if stat, ok := stats[name]; ok {
+		v = stat(s)
+	} else if f, ok := s.Fields[strings.TrimPrefix(name, "field.")]; ok && strings.HasPrefix(name, "field.") {
+		v = f
+	} else {
+		return 0, false
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
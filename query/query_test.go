@@ -0,0 +1,85 @@
+package query
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want time.Duration
+		err  bool
+	}{
+		{"90s", 90 * time.Second, false},
+		{"7d", 7 * 24 * time.Hour, false},
+		{"1w12h", 7*24*time.Hour + 12*time.Hour, false},
+		{"2w3d", 17 * 24 * time.Hour, false},
+		{"1d", 24 * time.Hour, false},
+		{"", 0, true},
+		{"-1h", 0, true},
+		{"+1h", 0, true},
+		{"1d-2h", 0, true},
+		{"xd", 0, true},
+		{"1y", 0, true},
+	} {
+		got, err := ParseDuration(test.s)
+		if (err != nil) != test.err {
+			t.Errorf("ParseDuration(%q): got error %v, want error %t", test.s, err, test.err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseDuration(%q) = %s, want %s", test.s, got, test.want)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		s    string
+		want time.Time
+		err  bool
+	}{
+		{"now", now, false},
+		{"now-7d", now.Add(-7 * 24 * time.Hour), false},
+		{"now+1h", now.Add(time.Hour), false},
+		{"24h", now.Add(-24 * time.Hour), false},
+		{"2026-10-17T09:00:00Z", now.Add(-24 * time.Hour), false},
+		{"2026-10-17T09:00:00.5+02:00", time.Date(2026, 10, 17, 7, 0, 0, 5e8, time.UTC), false},
+		{"-1h", time.Time{}, true},
+		{"+1h", time.Time{}, true},
+		{"now-", time.Time{}, true},
+		{"now--1h", time.Time{}, true},
+		{"yesterday", time.Time{}, true},
+	} {
+		got, err := ParseTime(test.s, now)
+		if (err != nil) != test.err {
+			t.Errorf("ParseTime(%q): got error %v, want error %t", test.s, err, test.err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("ParseTime(%q) = %s, want %s", test.s, got, test.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		name string
+		req  Request
+		err  bool
+	}{
+		{"valid", Request{From: now.Add(-time.Hour), To: now, Step: time.Minute}, false},
+		{"short step", Request{From: now.Add(-time.Hour), To: now, Step: time.Millisecond}, true},
+		{"empty range", Request{From: now, To: now, Step: time.Second}, true},
+		{"reversed range", Request{From: now, To: now.Add(-time.Hour), Step: time.Second}, true},
+		{"at most MaxPoints", Request{From: now.Add(-MaxPoints * time.Second), To: now, Step: time.Second}, false},
+		{"too many points", Request{From: now.Add(-(MaxPoints + 1) * time.Second), To: now, Step: time.Second}, true},
+	} {
+		if err := test.req.Validate(); (err != nil) != test.err {
+			t.Errorf("%s: got error %v, want error %t", test.name, err, test.err)
+		}
+	}
+}
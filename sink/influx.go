@@ -0,0 +1,36 @@
+package sink
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/export"
+)
+
+// influxSink posts results in InfluxDB line protocol, in the layout of
+// "checker export -format influx". The URL is the write endpoint, e.g.
+// http://localhost:8086/write?db=checker, or /api/v2/write?org=...&bucket=...
+// with an "Authorization: Token ..." header.
+type influxSink struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (s *influxSink) Send(batch []common.Result) error {
+	var buf bytes.Buffer
+	enc, err := export.NewEncoder(&buf, "influx")
+	if err != nil {
+		return &PermanentError{err}
+	}
+	for _, r := range batch {
+		if err = enc.Encode(r); err != nil {
+			return &PermanentError{err}
+		}
+	}
+	if err = enc.Flush(); err != nil {
+		return &PermanentError{err}
+	}
+	return post(s.client, s.url, s.headers, buf.Bytes(), "text/plain; charset=utf-8")
+}
@@ -0,0 +1,134 @@
+package sink
+
+import (
+	"encoding/binary"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/metrics"
+)
+
+// remoteWriteSink sends results to a Prometheus remote_write endpoint as a
+// snappy compressed WriteRequest. Every result becomes a sample of
+// checker_probe_up, one of checker_probe_latency_seconds if the probe
+// succeeded, and one of checker_probe_<field> per probe field, labelled
+// like the series on /metrics.
+type remoteWriteSink struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (s *remoteWriteSink) Send(batch []common.Result) error {
+	headers := map[string]string{
+		"Content-Encoding":                  "snappy",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+	}
+	for key, value := range s.headers {
+		headers[key] = value
+	}
+	body := encodeSnappy(encodeWriteRequest(batch))
+	return post(s.client, s.url, headers, body, "application/x-protobuf")
+}
+
+// sample is a value of a time series at a time in milliseconds.
+type sample struct {
+	value     float64
+	timestamp int64
+}
+
+// timeSeries is a metric name and its labels along with its samples.
+type timeSeries struct {
+	labels  []metrics.Label // sorted by name, __name__ included
+	samples []sample
+}
+
+// encodeWriteRequest encodes the results as a WriteRequest of the remote
+// write protocol, with one time series per metric and series of the batch.
+func encodeWriteRequest(batch []common.Result) []byte {
+	var series []*timeSeries
+	index := make(map[string]*timeSeries)
+	add := func(name string, labels []metrics.Label, v float64, timestamp int64) {
+		var key strings.Builder
+		key.WriteString(name)
+		for _, l := range labels {
+			key.WriteString("\xff" + l.Name + "\xff" + l.Value)
+		}
+		ts, ok := index[key.String()]
+		if !ok {
+			ls := append([]metrics.Label{{Name: "__name__", Value: name}}, labels...)
+			sort.Slice(ls, func(i, j int) bool { return ls[i].Name < ls[j].Name })
+			ts = &timeSeries{labels: ls}
+			index[key.String()] = ts
+			series = append(series, ts)
+		}
+		ts.samples = append(ts.samples, sample{v, timestamp})
+	}
+	for _, r := range batch {
+		labels := metrics.Labels(r.Series, r.Labels)
+		timestamp := r.Response.Time.UnixNano() / 1e6
+		up := 0.0
+		if r.Response.IsUp {
+			up = 1
+			add("checker_probe_latency_seconds", labels, r.Response.Latency.Seconds(), timestamp)
+		}
+		add("checker_probe_up", labels, up, timestamp)
+		for field, v := range r.Response.Fields {
+			add("checker_probe_"+metricName(field), labels, v, timestamp)
+		}
+	}
+	var req []byte
+	for _, ts := range series {
+		// Samples of a series must be in order, spooled results may not be
+		sort.SliceStable(ts.samples, func(i, j int) bool { return ts.samples[i].timestamp < ts.samples[j].timestamp })
+		var m []byte
+		for _, l := range ts.labels {
+			var label []byte
+			label = appendString(label, 1, l.Name)
+			label = appendString(label, 2, l.Value)
+			m = appendBytes(m, 1, label)
+		}
+		for _, s := range ts.samples {
+			var sm []byte
+			sm = appendKey(sm, 1, 1)
+			sm = binary.LittleEndian.AppendUint64(sm, math.Float64bits(s.value))
+			sm = appendKey(sm, 2, 0)
+			sm = binary.AppendUvarint(sm, uint64(s.timestamp))
+			m = appendBytes(m, 2, sm)
+		}
+		req = appendBytes(req, 1, m)
+	}
+	return req
+}
+
+// metricName replaces the characters of a probe field that are not allowed
+// in metric names with underscores.
+func metricName(field string) string {
+	return strings.Map(func(c rune) rune {
+		if c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			return c
+		}
+		return '_'
+	}, field)
+}
+
+// appendKey appends the key of a protobuf field with the given wire type.
+func appendKey(b []byte, field, wireType int) []byte {
+	return binary.AppendUvarint(b, uint64(field<<3|wireType))
+}
+
+// appendBytes appends a length delimited protobuf field.
+func appendBytes(b []byte, field int, v []byte) []byte {
+	b = appendKey(b, field, 2)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendString(b []byte, field int, v string) []byte {
+	b = appendKey(b, field, 2)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
@@ -0,0 +1,219 @@
+package sink
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+)
+
+// Sink sends batches of results to an external system.
+type Sink interface {
+	// Send delivers a batch. Errors that retrying won't fix are of type
+	// *PermanentError.
+	Send(batch []common.Result) error
+}
+
+// PermanentError is returned by sinks for batches the external system
+// rejected, so that they are dropped rather than retried.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string { return e.Err.Error() }
+
+// New returns the sink a [[sink]] entry of the config describes.
+func New(c config.Sink) (Sink, error) {
+	client := &http.Client{Timeout: c.Timeout.Duration}
+	switch c.Type {
+	case "influx":
+		return &influxSink{client: client, url: c.URL, headers: c.Headers}, nil
+	case "remote_write":
+		return &remoteWriteSink{client: client, url: c.URL, headers: c.Headers}, nil
+	}
+	return nil, fmt.Errorf("Unknown sink type %q", c.Type)
+}
+
+// post sends body to url and checks the response. Client errors other than
+// 429 Too Many Requests are permanent.
+func post(client *http.Client, url string, headers map[string]string, body []byte, contentType string) error {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return &PermanentError{fmt.Errorf("Failed to create request: %s", err.Error())}
+	}
+	req.Header.Set("Content-Type", contentType)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Failed to send payload: %s", err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		// Drain the body so the connection can be reused
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("Payload sent, but got %d error: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	if resp.StatusCode/100 == 4 && resp.StatusCode != http.StatusTooManyRequests {
+		return &PermanentError{err}
+	}
+	return err
+}
+
+// Forwarder buffers results for a sink and sends them in batches from a
+// goroutine of its own, retrying failed batches with backoff. Results that
+// don't fit into the buffer are dropped, so that a slow sink never holds up
+// the caller.
+type Forwarder struct {
+	name     string
+	sink     Sink
+	queue    chan common.Result
+	size     int
+	interval time.Duration
+	retries  int
+	backoff  time.Duration // before the first retry, doubled for every other
+	dropped  uint64        // atomic, results dropped since the last report
+	done     chan struct{}
+}
+
+// NewForwarder starts forwarding to the sink a [[sink]] entry of the config
+// describes.
+func NewForwarder(c config.Sink) (*Forwarder, error) {
+	s, err := New(c)
+	if err != nil {
+		return nil, err
+	}
+	f := &Forwarder{
+		name:     fmt.Sprintf("%s sink %s", c.Type, c.URL),
+		sink:     s,
+		queue:    make(chan common.Result, c.BufferSize),
+		size:     c.BatchSize,
+		interval: c.BatchInterval.Duration,
+		retries:  c.MaxRetries,
+		backoff:  time.Second,
+		done:     make(chan struct{}),
+	}
+	go f.run()
+	return f, nil
+}
+
+// Write queues results for the sink without blocking.
+func (f *Forwarder) Write(results ...common.Result) {
+	for _, r := range results {
+		select {
+		case f.queue <- r:
+		default:
+			atomic.AddUint64(&f.dropped, 1)
+		}
+	}
+}
+
+// Close sends what is buffered and stops the forwarder.
+func (f *Forwarder) Close() {
+	close(f.queue)
+	<-f.done
+}
+
+// run collects results and sends them once size of them piled up or
+// interval passed, whatever comes first.
+func (f *Forwarder) run() {
+	defer close(f.done)
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	batch := make([]common.Result, 0, f.size)
+	for {
+		select {
+		case r, ok := <-f.queue:
+			if !ok {
+				if len(batch) > 0 {
+					f.send(batch)
+				}
+				return
+			}
+			batch = append(batch, r)
+			if len(batch) < f.size {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		f.send(batch)
+		batch = batch[:0]
+	}
+}
+
+// send delivers a batch, retrying it with exponential backoff up to the
+// configured number of times before dropping it.
+func (f *Forwarder) send(batch []common.Result) {
+	backoff := f.backoff
+	for attempt := 0; ; attempt++ {
+		err := f.sink.Send(batch)
+		if err == nil {
+			break
+		}
+		if _, ok := err.(*PermanentError); ok || attempt == f.retries {
+			log.Printf("Dropping %d results for %s: %s\n", len(batch), f.name, err.Error())
+			break
+		}
+		log.Printf("Failed to send %d results to %s, retrying in %s: %s\n", len(batch), f.name, backoff, err.Error())
+		time.Sleep(backoff)
+		if backoff < time.Minute {
+			backoff *= 2
+		}
+	}
+	if n := atomic.SwapUint64(&f.dropped, 0); n > 0 {
+		log.Printf("Dropped %d results for %s, its buffer was full\n", n, f.name)
+	}
+}
+
+// Group forwards results to several sinks. A nil *Group forwards to none.
+type Group struct {
+	forwarders []*Forwarder
+}
+
+// Open starts forwarding to every sink of the config.
+func Open(sinks []config.Sink) (*Group, error) {
+	g := &Group{}
+	for _, c := range sinks {
+		f, err := NewForwarder(c)
+		if err != nil {
+			g.Close()
+			return nil, err
+		}
+		log.Printf("Forwarding results to %s\n", f.name)
+		g.forwarders = append(g.forwarders, f)
+	}
+	return g, nil
+}
+
+// Write queues results for every sink of the group.
+func (g *Group) Write(results ...common.Result) {
+	if g == nil {
+		return
+	}
+	for _, f := range g.forwarders {
+		f.Write(results...)
+	}
+}
+
+// Close sends what is buffered and stops forwarding.
+func (g *Group) Close() {
+	if g == nil {
+		return
+	}
+	for _, f := range g.forwarders {
+		f.Close()
+	}
+}
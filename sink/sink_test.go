@@ -0,0 +1,385 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/export"
+)
+
+var testResults = []common.Result{
+	{
+		Series: common.Series{Agent: "laptop", Interface: "wlan0", Probe: "http", Target: "example.com"},
+		Labels: map[string]string{"site": "home", "bad-name": "left out"},
+		Response: common.Response{
+			Time: time.Date(2026, 10, 18, 9, 0, 1, 0, time.UTC), IsUp: true, Latency: 250 * time.Millisecond,
+			Fields: map[string]float64{"ttfb": 0.2, "tls.handshake": 0.05},
+		},
+	},
+	{
+		Series:   common.Series{Agent: "laptop", Interface: "wlan0", Probe: "http", Target: "example.com"},
+		Labels:   map[string]string{"site": "home", "bad-name": "left out"},
+		Response: common.Response{Time: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), Error: "timeout", Latency: 5 * time.Second},
+	},
+}
+
+// decodeSnappy decompresses the snappy block format.
+func decodeSnappy(src []byte) ([]byte, error) {
+	n, size := binary.Uvarint(src)
+	if size <= 0 {
+		return nil, errors.New("invalid length")
+	}
+	src = src[size:]
+	var dst []byte
+	for len(src) > 0 {
+		tag := src[0]
+		var length, offset int
+		switch tag & 3 {
+		case tagLiteral:
+			length = int(tag>>2) + 1
+			src = src[1:]
+			if extra := length - 60; extra > 0 {
+				if len(src) < extra {
+					return nil, errors.New("short literal length")
+				}
+				length = 0
+				for i := extra - 1; i >= 0; i-- {
+					length = length<<8 | int(src[i])
+				}
+				length++
+				src = src[extra:]
+			}
+			if len(src) < length {
+				return nil, errors.New("short literal")
+			}
+			dst = append(dst, src[:length]...)
+			src = src[length:]
+			continue
+		case 1:
+			if len(src) < 2 {
+				return nil, errors.New("short copy")
+			}
+			length = int(tag>>2&7) + 4
+			offset = int(tag>>5)<<8 | int(src[1])
+			src = src[2:]
+		case tagCopy2:
+			if len(src) < 3 {
+				return nil, errors.New("short copy")
+			}
+			length = int(tag>>2) + 1
+			offset = int(binary.LittleEndian.Uint16(src[1:]))
+			src = src[3:]
+		default:
+			if len(src) < 5 {
+				return nil, errors.New("short copy")
+			}
+			length = int(tag>>2) + 1
+			offset = int(binary.LittleEndian.Uint32(src[1:]))
+			src = src[5:]
+		}
+		if offset <= 0 || offset > len(dst) {
+			return nil, fmt.Errorf("invalid offset %d", offset)
+		}
+		// Copies may overlap what they append
+		for i := 0; i < length; i++ {
+			dst = append(dst, dst[len(dst)-offset])
+		}
+	}
+	if uint64(len(dst)) != n {
+		return nil, fmt.Errorf("decoded %d bytes, expected %d", len(dst), n)
+	}
+	return dst, nil
+}
+
+func TestSnappy(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 100000)
+	r.Read(random)
+	repetitive := bytes.Repeat([]byte("checker_probe_up\xffagent\xfflaptop\xff"), 5000)
+	for name, src := range map[string][]byte{
+		"empty":      {},
+		"short":      []byte("abc"),
+		"random":     random,
+		"repetitive": repetitive,
+		"mixed":      append(append([]byte(nil), repetitive[:70000]...), random[:70000]...),
+	} {
+		enc := encodeSnappy(src)
+		got, err := decodeSnappy(enc)
+		if err != nil {
+			t.Errorf("%s: %s", name, err.Error())
+			continue
+		}
+		if !bytes.Equal(got, src) {
+			t.Errorf("%s: decoded bytes differ", name)
+		}
+		if name == "repetitive" && len(enc) > len(src)/10 {
+			t.Errorf("%s: compressed %d bytes to %d", name, len(src), len(enc))
+		}
+	}
+}
+
+// field is a protobuf field, its value in varint, fixed or bytes depending
+// on its wire type.
+type field struct {
+	num   int
+	wire  int
+	fixed uint64
+	bytes []byte
+}
+
+func decodeProto(b []byte) ([]field, error) {
+	var fields []field
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, errors.New("invalid key")
+		}
+		b = b[n:]
+		f := field{num: int(key >> 3), wire: int(key & 7)}
+		switch f.wire {
+		case 0:
+			f.fixed, n = binary.Uvarint(b)
+			if n <= 0 {
+				return nil, errors.New("invalid varint")
+			}
+			b = b[n:]
+		case 1:
+			if len(b) < 8 {
+				return nil, errors.New("short fixed64")
+			}
+			f.fixed = binary.LittleEndian.Uint64(b)
+			b = b[8:]
+		case 2:
+			length, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b[n:])) < length {
+				return nil, errors.New("invalid length")
+			}
+			f.bytes = b[n : n+int(length)]
+			b = b[n+int(length):]
+		default:
+			return nil, fmt.Errorf("unexpected wire type %d", f.wire)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+type decodedSeries struct {
+	labels  map[string]string
+	samples []sample
+}
+
+// decodeWriteRequest decodes a WriteRequest into its series, by metric name.
+func decodeWriteRequest(b []byte) (map[string]decodedSeries, error) {
+	fields, err := decodeProto(b)
+	if err != nil {
+		return nil, err
+	}
+	series := make(map[string]decodedSeries)
+	for _, f := range fields {
+		if f.num != 1 || f.wire != 2 {
+			return nil, fmt.Errorf("unexpected field %d of WriteRequest", f.num)
+		}
+		tsFields, err := decodeProto(f.bytes)
+		if err != nil {
+			return nil, err
+		}
+		ts := decodedSeries{labels: make(map[string]string)}
+		for _, tf := range tsFields {
+			sub, err := decodeProto(tf.bytes)
+			if err != nil {
+				return nil, err
+			}
+			switch tf.num {
+			case 1:
+				if len(sub) != 2 || sub[0].num != 1 || sub[1].num != 2 {
+					return nil, errors.New("invalid label")
+				}
+				ts.labels[string(sub[0].bytes)] = string(sub[1].bytes)
+			case 2:
+				if len(sub) != 2 || sub[0].num != 1 || sub[0].wire != 1 || sub[1].num != 2 || sub[1].wire != 0 {
+					return nil, errors.New("invalid sample")
+				}
+				ts.samples = append(ts.samples, sample{math.Float64frombits(sub[0].fixed), int64(sub[1].fixed)})
+			default:
+				return nil, fmt.Errorf("unexpected field %d of TimeSeries", tf.num)
+			}
+		}
+		name := ts.labels["__name__"]
+		if _, ok := series[name]; ok {
+			return nil, fmt.Errorf("%s sent twice", name)
+		}
+		series[name] = ts
+	}
+	return series, nil
+}
+
+// stub stands in for an external system. It answers the requests with the
+// given status codes in turn, and with 204 No Content after them.
+type stub struct {
+	*httptest.Server
+	mu       sync.Mutex
+	codes    []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newStub(codes ...int) *stub {
+	s := &stub{codes: codes}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, r)
+		s.bodies = append(s.bodies, body)
+		code := http.StatusNoContent
+		if len(s.codes) > 0 {
+			code, s.codes = s.codes[0], s.codes[1:]
+		}
+		if code/100 != 2 {
+			http.Error(w, http.StatusText(code), code)
+			return
+		}
+		w.WriteHeader(code)
+	}))
+	return s
+}
+
+func TestRemoteWriteSink(t *testing.T) {
+	s := newStub()
+	defer s.Close()
+	sink := &remoteWriteSink{client: s.Client(), url: s.URL, headers: map[string]string{"Authorization": "Bearer secret"}}
+	if err := sink.Send(testResults); err != nil {
+		t.Fatal(err)
+	}
+	r := s.requests[0]
+	for header, want := range map[string]string{
+		"Content-Encoding":                  "snappy",
+		"Content-Type":                      "application/x-protobuf",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+		"Authorization":                     "Bearer secret",
+	} {
+		if got := r.Header.Get(header); got != want {
+			t.Errorf("Expected %s %q, got %q", header, want, got)
+		}
+	}
+	body, err := decodeSnappy(s.bodies[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	series, err := decodeWriteRequest(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := map[string]string{"agent": "laptop", "interface": "wlan0", "probe": "http", "target": "example.com", "site": "home"}
+	up, down := testResults[0].Response.Time.UnixNano()/1e6, testResults[1].Response.Time.UnixNano()/1e6
+	for name, want := range map[string][]sample{
+		// Sorted by time, and the latency only of the result that is up
+		"checker_probe_up":              {{0, down}, {1, up}},
+		"checker_probe_latency_seconds": {{0.25, up}},
+		"checker_probe_ttfb":            {{0.2, up}},
+		"checker_probe_tls_handshake":   {{0.05, up}},
+	} {
+		ts, ok := series[name]
+		if !ok {
+			t.Errorf("Expected series %s", name)
+			continue
+		}
+		wantLabels := map[string]string{"__name__": name}
+		for k, v := range labels {
+			wantLabels[k] = v
+		}
+		if !reflect.DeepEqual(ts.labels, wantLabels) {
+			t.Errorf("%s: labels %v, want %v", name, ts.labels, wantLabels)
+		}
+		if !reflect.DeepEqual(ts.samples, want) {
+			t.Errorf("%s: samples %v, want %v", name, ts.samples, want)
+		}
+	}
+	if len(series) != 4 {
+		t.Errorf("Expected 4 series, got %d", len(series))
+	}
+}
+
+func TestInfluxSink(t *testing.T) {
+	s := newStub()
+	defer s.Close()
+	sink := &influxSink{client: s.Client(), url: s.URL + "/write?db=checker", headers: map[string]string{"Authorization": "Token secret"}}
+	if err := sink.Send(testResults); err != nil {
+		t.Fatal(err)
+	}
+	r := s.requests[0]
+	if r.URL.Path != "/write" || r.URL.Query().Get("db") != "checker" {
+		t.Errorf("Expected a post to /write?db=checker, got %s", r.URL)
+	}
+	if got := r.Header.Get("Authorization"); got != "Token secret" {
+		t.Errorf("Expected the configured Authorization header, got %q", got)
+	}
+	dec, err := export.NewDecoder(bytes.NewReader(s.bodies[0]), "influx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range testResults {
+		got, err := dec.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Sent %+v, want %+v", got, want)
+		}
+	}
+	if _, err = dec.Decode(); err != io.EOF {
+		t.Errorf("Expected %d points, got more", len(testResults))
+	}
+}
+
+func TestForwarderRetries(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		codes    []int
+		attempts int
+	}{
+		{"delivered", nil, 1},
+		{"server errors are retried", []int{503, 500}, 3},
+		{"too many requests are retried", []int{429}, 2},
+		{"client errors are dropped", []int{400}, 1},
+		{"retries run out", []int{503, 503, 503, 503, 503}, 4},
+	} {
+		s := newStub(test.codes...)
+		f := &Forwarder{
+			name:     test.name,
+			sink:     &influxSink{client: s.Client(), url: s.URL},
+			queue:    make(chan common.Result, 10),
+			size:     len(testResults),
+			interval: time.Hour,
+			retries:  3,
+			backoff:  time.Millisecond,
+			done:     make(chan struct{}),
+		}
+		go f.run()
+		f.Write(testResults...)
+		f.Close()
+		s.Close()
+		if len(s.requests) != test.attempts {
+			t.Errorf("%s: expected %d attempts, got %d", test.name, test.attempts, len(s.requests))
+		}
+		for i, body := range s.bodies {
+			if !bytes.Equal(body, s.bodies[0]) {
+				t.Errorf("%s: attempt %d sent another batch", test.name, i+1)
+			}
+		}
+	}
+}
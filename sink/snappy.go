@@ -0,0 +1,91 @@
+package sink
+
+import "encoding/binary"
+
+// The remote write protocol compresses requests in the snappy block format,
+// https://github.com/google/snappy/blob/main/format_description.txt. Only
+// the encoder is needed, and a simple one compresses the repetitive label
+// sets of a WriteRequest well enough.
+
+const (
+	// snappyBlockSize bounds the input searched for matches at once, so
+	// that offsets fit into the two bytes of a copy.
+	snappyBlockSize = 1 << 16
+	snappyTableBits = 14
+
+	tagLiteral = 0x00
+	tagCopy2   = 0x02
+)
+
+// encodeSnappy compresses src in the snappy block format.
+func encodeSnappy(src []byte) []byte {
+	dst := binary.AppendUvarint(make([]byte, 0, len(src)/2+16), uint64(len(src)))
+	for len(src) > 0 {
+		block := src
+		if len(block) > snappyBlockSize {
+			block = block[:snappyBlockSize]
+		}
+		dst = encodeSnappyBlock(dst, block)
+		src = src[len(block):]
+	}
+	return dst
+}
+
+// encodeSnappyBlock finds earlier occurrences of every 4 bytes of src with
+// a hash table, emitting copies for the matches and literals in between.
+func encodeSnappyBlock(dst, src []byte) []byte {
+	var table [1 << snappyTableBits]int32 // position + 1 of the last occurrence of a hash
+	literal := 0
+	for s := 0; s+4 <= len(src); {
+		v := binary.LittleEndian.Uint32(src[s:])
+		h := (v * 0x1e35a7bd) >> (32 - snappyTableBits)
+		candidate := int(table[h]) - 1
+		table[h] = int32(s + 1)
+		if candidate < 0 || binary.LittleEndian.Uint32(src[candidate:]) != v {
+			s++
+			continue
+		}
+		n := 4
+		for s+n < len(src) && src[candidate+n] == src[s+n] {
+			n++
+		}
+		dst = emitLiteral(dst, src[literal:s])
+		dst = emitCopy(dst, s-candidate, n)
+		s += n
+		literal = s
+	}
+	return emitLiteral(dst, src[literal:])
+}
+
+func emitLiteral(dst, lit []byte) []byte {
+	if len(lit) == 0 {
+		return dst
+	}
+	n := len(lit) - 1
+	switch {
+	case n < 60:
+		dst = append(dst, byte(n)<<2|tagLiteral)
+	case n < 1<<8:
+		dst = append(dst, 60<<2|tagLiteral, byte(n))
+	case n < 1<<16:
+		dst = append(dst, 61<<2|tagLiteral, byte(n), byte(n>>8))
+	case n < 1<<24:
+		dst = append(dst, 62<<2|tagLiteral, byte(n), byte(n>>8), byte(n>>16))
+	default:
+		dst = append(dst, 63<<2|tagLiteral, byte(n), byte(n>>8), byte(n>>16), byte(n>>24))
+	}
+	return append(dst, lit...)
+}
+
+// emitCopy emits copies of at most 64 bytes with a two byte offset.
+func emitCopy(dst []byte, offset, length int) []byte {
+	for length > 0 {
+		n := length
+		if n > 64 {
+			n = 64
+		}
+		dst = append(dst, byte(n-1)<<2|tagCopy2, byte(offset), byte(offset>>8))
+		length -= n
+	}
+	return dst
+}
@@ -0,0 +1,235 @@
+// Package sketch implements a mergeable quantile sketch with relative error
+// guarantees, following DDSketch (https://arxiv.org/abs/1908.10693).
+//
+// Values are counted in logarithmically sized bins, so any quantile of the
+// values added is returned with a relative error of at most Accuracy, no
+// matter how many values or merged sketches it is computed from.
+package sketch
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Accuracy is the relative error of quantiles returned by a Sketch.
+const Accuracy = 0.01
+
+// minValue is the smallest value told apart from zero. Latencies are
+// recorded in seconds, so this is well below a nanosecond.
+const minValue = 1e-12
+
+const encodingVersion = 1
+
+var (
+	gamma    = (1 + Accuracy) / (1 - Accuracy)
+	logGamma = math.Log(gamma)
+)
+
+// Sketch summarizes a set of non-negative values. The zero value is not
+// usable, create sketches with New.
+type Sketch struct {
+	bins  map[int32]uint64
+	zero  uint64 // values below minValue
+	count uint64
+	sum   float64
+	min   float64
+	max   float64
+}
+
+func New() *Sketch {
+	return &Sketch{bins: make(map[int32]uint64), min: math.Inf(1), max: math.Inf(-1)}
+}
+
+func index(v float64) int32 {
+	return int32(math.Ceil(math.Log(v) / logGamma))
+}
+
+func value(i int32) float64 {
+	return 2 * math.Pow(gamma, float64(i)) / (gamma + 1)
+}
+
+// Add records v. Negative values are counted as zero.
+func (s *Sketch) Add(v float64) {
+	if v < minValue {
+		s.zero++
+	} else {
+		s.bins[index(v)]++
+	}
+	s.count++
+	s.sum += v
+	s.min = math.Min(s.min, v)
+	s.max = math.Max(s.max, v)
+}
+
+// Merge adds all values recorded by o to s.
+func (s *Sketch) Merge(o *Sketch) {
+	for i, n := range o.bins {
+		s.bins[i] += n
+	}
+	s.zero += o.zero
+	s.count += o.count
+	s.sum += o.sum
+	s.min = math.Min(s.min, o.min)
+	s.max = math.Max(s.max, o.max)
+}
+
+// Copy returns an independent copy of s.
+func (s *Sketch) Copy() *Sketch {
+	c := *s
+	c.bins = make(map[int32]uint64, len(s.bins))
+	for i, n := range s.bins {
+		c.bins[i] = n
+	}
+	return &c
+}
+
+func (s *Sketch) Count() uint64 { return s.count }
+func (s *Sketch) Sum() float64  { return s.sum }
+
+// Min returns the smallest value recorded, or 0 for an empty sketch.
+func (s *Sketch) Min() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.min
+}
+
+// Max returns the largest value recorded, or 0 for an empty sketch.
+func (s *Sketch) Max() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.max
+}
+
+// Quantile returns the q-quantile of the recorded values, 0 <= q <= 1, or
+// 0 for an empty sketch.
+func (s *Sketch) Quantile(q float64) float64 {
+	if s.count == 0 {
+		return 0
+	}
+	if q <= 0 {
+		return s.min
+	}
+	if q >= 1 {
+		return s.max
+	}
+	rank := uint64(q * float64(s.count-1))
+	if rank < s.zero {
+		return 0
+	}
+	seen := s.zero
+	keys := make([]int, 0, len(s.bins))
+	for i := range s.bins {
+		keys = append(keys, int(i))
+	}
+	sort.Ints(keys)
+	for _, i := range keys {
+		seen += s.bins[int32(i)]
+		if seen > rank {
+			return math.Max(s.min, math.Min(s.max, value(int32(i))))
+		}
+	}
+	return s.max
+}
+
+// MarshalBinary encodes the sketch as a version byte, the count of zero
+// values, the sum, min and max, and the number of bins followed by each
+// bin's index delta as a zigzag varint and its count as a uvarint.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	keys := make([]int, 0, len(s.bins))
+	for i := range s.bins {
+		keys = append(keys, int(i))
+	}
+	sort.Ints(keys)
+	b := make([]byte, 0, 32+len(keys)*3)
+	b = append(b, encodingVersion)
+	b = binary.AppendUvarint(b, s.zero)
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(s.sum))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(s.Min()))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(s.Max()))
+	b = binary.AppendUvarint(b, uint64(len(keys)))
+	prev := 0
+	for _, i := range keys {
+		b = binary.AppendVarint(b, int64(i-prev))
+		b = binary.AppendUvarint(b, s.bins[int32(i)])
+		prev = i
+	}
+	return b, nil
+}
+
+var errCorrupt = errors.New("sketch: corrupt encoding")
+
+func (s *Sketch) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return errCorrupt
+	}
+	if b[0] != encodingVersion {
+		return fmt.Errorf("sketch: unknown encoding version %d", b[0])
+	}
+	b = b[1:]
+	*s = *New()
+	var n int
+	s.zero, n = binary.Uvarint(b)
+	if n <= 0 || len(b[n:]) < 24 {
+		return errCorrupt
+	}
+	b = b[n:]
+	s.sum = math.Float64frombits(binary.BigEndian.Uint64(b))
+	min := math.Float64frombits(binary.BigEndian.Uint64(b[8:]))
+	max := math.Float64frombits(binary.BigEndian.Uint64(b[16:]))
+	b = b[24:]
+	bins, n := binary.Uvarint(b)
+	if n <= 0 {
+		return errCorrupt
+	}
+	b = b[n:]
+	s.count = s.zero
+	i := int64(0)
+	for j := uint64(0); j < bins; j++ {
+		delta, n := binary.Varint(b)
+		if n <= 0 {
+			return errCorrupt
+		}
+		b = b[n:]
+		count, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errCorrupt
+		}
+		b = b[n:]
+		i += delta
+		s.bins[int32(i)] = count
+		s.count += count
+	}
+	if s.count > 0 {
+		s.min, s.max = min, max
+	}
+	return nil
+}
+
+// MarshalJSON encodes the sketch as the base64 string of its binary form.
+func (s *Sketch) MarshalJSON() ([]byte, error) {
+	b, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(base64.StdEncoding.EncodeToString(b))
+}
+
+func (s *Sketch) UnmarshalJSON(data []byte) error {
+	var encoded string
+	err := json.Unmarshal(data, &encoded)
+	if err != nil {
+		return err
+	}
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalBinary(b)
+}
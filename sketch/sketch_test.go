@@ -0,0 +1,160 @@
+package sketch
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+var quantiles = []float64{0, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.95, 0.99, 0.999, 1}
+
+// distributions generate latency-like values in seconds.
+var distributions = map[string]func(r *rand.Rand) float64{
+	"uniform":     func(r *rand.Rand) float64 { return r.Float64() },
+	"exponential": func(r *rand.Rand) float64 { return r.ExpFloat64() / 100 },
+	"lognormal":   func(r *rand.Rand) float64 { return math.Exp(r.NormFloat64()*2 - 5) },
+	"bimodal": func(r *rand.Rand) float64 {
+		if r.Intn(10) == 0 {
+			return 0.2 + r.Float64()*0.05
+		}
+		return 0.001 + r.Float64()*0.0005
+	},
+}
+
+func sample(name string, n int, seed int64) []float64 {
+	r := rand.New(rand.NewSource(seed))
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = distributions[name](r)
+	}
+	return values
+}
+
+// exact returns the q-quantile of values by the rank Quantile uses.
+func exact(values []float64, q float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+func checkQuantiles(t *testing.T, s *Sketch, values []float64) {
+	t.Helper()
+	for _, q := range quantiles {
+		want := exact(values, q)
+		got := s.Quantile(q)
+		if math.Abs(got-want) > Accuracy*want {
+			t.Errorf("Quantile(%g) = %g, want %g within %g%%", q, got, want, Accuracy*100)
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	for name := range distributions {
+		t.Run(name, func(t *testing.T) {
+			values := sample(name, 100000, 1)
+			s := New()
+			for _, v := range values {
+				s.Add(v)
+			}
+			if s.Count() != uint64(len(values)) {
+				t.Errorf("Count() = %d, want %d", s.Count(), len(values))
+			}
+			checkQuantiles(t, s, values)
+		})
+	}
+}
+
+func TestQuantileZeros(t *testing.T) {
+	s := New()
+	values := []float64{0, 0, 0, 1, 2}
+	for _, v := range values {
+		s.Add(v)
+	}
+	checkQuantiles(t, s, values)
+	if empty := New(); empty.Quantile(0.5) != 0 || empty.Min() != 0 || empty.Max() != 0 {
+		t.Errorf("Expected an empty sketch to return 0")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := sample("lognormal", 50000, 2)
+	b := sample("bimodal", 30000, 3)
+	all := New()
+	sa, sb := New(), New()
+	for _, v := range a {
+		sa.Add(v)
+		all.Add(v)
+	}
+	for _, v := range b {
+		sb.Add(v)
+		all.Add(v)
+	}
+	merged := sa.Copy()
+	merged.Merge(sb)
+	// Sums differ in rounding only, they are added up in another order
+	if math.Abs(merged.Sum()-all.Sum()) > 1e-9*all.Sum() {
+		t.Errorf("Sum() = %g, want %g", merged.Sum(), all.Sum())
+	}
+	merged.sum = all.sum
+	if !reflect.DeepEqual(merged, all) {
+		t.Errorf("Merged sketch differs from the sketch of all values")
+	}
+	checkQuantiles(t, merged, append(a, b...))
+	// Copy must not share bins with the original
+	if sa.Count() != uint64(len(a)) || sa.Quantile(1) != exact(a, 1) {
+		t.Errorf("Merging into a copy changed the original")
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	s := New()
+	for _, v := range append(sample("exponential", 10000, 4), 0, 0) {
+		s.Add(v)
+	}
+	b, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Sketch
+	if err = decoded.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, s) {
+		t.Errorf("Decoded sketch differs from the encoded one")
+	}
+
+	j, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON Sketch
+	if err = json.Unmarshal(j, &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&fromJSON, s) {
+		t.Errorf("Sketch decoded from JSON differs from the encoded one")
+	}
+
+	for _, n := range []int{0, 1, 10, len(b) - 1} {
+		if err := new(Sketch).UnmarshalBinary(b[:n]); err == nil {
+			t.Errorf("Expected an error decoding %d of %d bytes", n, len(b))
+		}
+	}
+}
+
+func TestMarshalBinaryEmpty(t *testing.T) {
+	b, err := New().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Sketch
+	if err = decoded.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, New()) {
+		t.Errorf("Decoded empty sketch differs from New()")
+	}
+}
@@ -0,0 +1,127 @@
+package stream
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+// bufferSize is the number of events a subscriber may lag behind before
+// events are dropped for it.
+const bufferSize = 1024
+
+// Event is an aggregate that was flushed, a sample that came in or a change
+// of the state of an alert.
+type Event struct {
+	Type   string             `json:"type"` // "aggregate", "sample" or "alert"
+	Series string             `json:"series"`
+	Time   time.Time          `json:"time"`
+	Status *common.Status     `json:"status,omitempty"`
+	Sample *common.Response   `json:"sample,omitempty"`
+	Alert  *common.AlertEvent `json:"alert,omitempty"`
+}
+
+// Hub hands events to the subscribers of their series. It is safe for
+// concurrent use.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[*Subscription]bool
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: make(map[*Subscription]bool)}
+}
+
+// Subscription receives the events of some series on C until it is
+// closed.
+type Subscription struct {
+	C <-chan Event
+
+	c      chan Event
+	hub    *Hub
+	series map[string]bool // all series if empty
+	raw    bool
+}
+
+// Subscribe returns a subscription to the aggregates and the alerts of
+// series, all series if none are given, and with raw set also to their
+// samples.
+func (h *Hub) Subscribe(series []string, raw bool) *Subscription {
+	c := make(chan Event, bufferSize)
+	s := &Subscription{C: c, c: c, hub: h, series: make(map[string]bool), raw: raw}
+	for _, key := range series {
+		s.series[key] = true
+	}
+	h.mu.Lock()
+	h.subs[s] = true
+	h.mu.Unlock()
+	return s
+}
+
+// Close ends the subscription and closes C.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	if s.hub.subs[s] {
+		delete(s.hub.subs, s)
+		close(s.c)
+	}
+}
+
+func (s *Subscription) wants(e Event) bool {
+	if e.Type == "sample" && !s.raw {
+		return false
+	}
+	return len(s.series) == 0 || s.series[e.Series]
+}
+
+// publish hands events to every subscriber that wants them. Subscribers
+// that lag behind miss events rather than hold up the publisher.
+func (h *Hub) publish(events ...Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subs {
+		for _, e := range events {
+			if !s.wants(e) {
+				continue
+			}
+			select {
+			case s.c <- e:
+			default:
+			}
+		}
+	}
+}
+
+// PublishAggregates publishes the aggregates of a series oldest first. It
+// has the signature of datastore.Cache.OnFlush.
+func (h *Hub) PublishAggregates(series string, status map[time.Time]common.Status) {
+	events := make([]Event, 0, len(status))
+	for t, s := range status {
+		// The sketches are only useful for merging, don't bloat the events
+		s.Sketch = nil
+		s.PhaseSketches = nil
+		s := s
+		events = append(events, Event{Type: "aggregate", Series: series, Time: t, Status: &s})
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	h.publish(events...)
+}
+
+// PublishSamples publishes results as they come in.
+func (h *Hub) PublishSamples(results ...common.Result) {
+	events := make([]Event, len(results))
+	for i := range results {
+		r := results[i]
+		events[i] = Event{Type: "sample", Series: r.Series.Key(), Time: r.Response.Time, Sample: &r.Response}
+	}
+	h.publish(events...)
+}
+
+// PublishAlert publishes a change of the state of an alert. It has the
+// signature of alert.Engine.OnEvent.
+func (h *Hub) PublishAlert(e common.AlertEvent) {
+	h.publish(Event{Type: "alert", Series: e.Series, Time: e.Time, Alert: &e})
+}
@@ -0,0 +1,227 @@
+package stream
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The WebSocket protocol is described in RFC 6455. Conn only implements
+// what streaming events needs: the server sends text messages and pings,
+// and reads from the client only to answer its pings and to notice when it
+// goes away.
+
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	opText  = 0x1
+	opClose = 0x8
+	opPing  = 0x9
+	opPong  = 0xa
+)
+
+// maxMessageSize bounds the messages read from clients, which are not
+// expected to send anything but control frames.
+const maxMessageSize = 1 << 16
+
+// writeTimeout bounds the time a client may take to accept a frame.
+const writeTimeout = 10 * time.Second
+
+// IsWebSocket reports whether r asks for a WebSocket.
+func IsWebSocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// Conn is the server side of a WebSocket connection.
+type Conn struct {
+	conn net.Conn
+	rw   *bufio.ReadWriter
+	mu   sync.Mutex // serializes writes
+}
+
+// Upgrade answers a WebSocket handshake and takes over the connection of
+// the request. Browsers send the origin of the page that opens a WebSocket,
+// and since they don't apply the same-origin policy to WebSockets, pages of
+// origins other than the server's own are only let in if they are one of
+// origins. If it fails, it has replied with an error already.
+func Upgrade(w http.ResponseWriter, r *http.Request, origins []string) (*Conn, error) {
+	if !headerContains(r.Header, "Connection", "upgrade") || !IsWebSocket(r) {
+		http.Error(w, "Expected a WebSocket handshake", http.StatusBadRequest)
+		return nil, errors.New("not a websocket handshake")
+	}
+	if origin := r.Header.Get("Origin"); !originAllowed(r, origins) {
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return nil, fmt.Errorf("origin %q not allowed", origin)
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, errors.New("unsupported websocket version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(w, "Missing Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, errors.New("missing websocket key")
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "WebSockets are not supported", http.StatusInternalServerError)
+		return nil, errors.New("response can not be hijacked")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	h := sha1.Sum([]byte(key + websocketGUID))
+	accept := base64.StdEncoding.EncodeToString(h[:])
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + accept + "\r\n\r\n")
+	if err = rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &Conn{conn: conn, rw: rw}, nil
+}
+
+// originAllowed reports whether r does not come from a browser, which
+// always sends an Origin, or comes from a page of the host it is sent to or
+// of one of origins.
+func originAllowed(r *http.Request, origins []string) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range origins {
+		if strings.EqualFold(origin, strings.TrimSuffix(allowed, "/")) {
+			return true
+		}
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+func headerContains(h http.Header, name, token string) bool {
+	for _, value := range h[http.CanonicalHeaderKey(name)] {
+		for _, t := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteText sends a text message.
+func (c *Conn) WriteText(msg []byte) error {
+	return c.writeFrame(opText, msg)
+}
+
+// Ping sends a ping, to keep proxies from closing an idle connection.
+func (c *Conn) Ping() error {
+	return c.writeFrame(opPing, nil)
+}
+
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	header := []byte{0x80 | opcode} // final fragment
+	switch n := len(payload); {
+	case n < 126:
+		header = append(header, byte(n))
+	case n < 1<<16:
+		header = append(header, 126)
+		header = binary.BigEndian.AppendUint16(header, uint16(n))
+	default:
+		header = append(header, 127)
+		header = binary.BigEndian.AppendUint64(header, uint64(n))
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	c.rw.Write(header)
+	c.rw.Write(payload)
+	return c.rw.Flush()
+}
+
+// ReadLoop reads from the client until it closes the connection, answering
+// its pings. It returns nil if the client closed the connection cleanly.
+func (c *Conn) ReadLoop() error {
+	for {
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+		switch opcode {
+		case opClose:
+			// Echo the status code, if any, and hang up
+			if len(payload) > 2 {
+				payload = payload[:2]
+			}
+			c.writeFrame(opClose, payload)
+			return nil
+		case opPing:
+			if err = c.writeFrame(opPong, payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *Conn) readFrame() (byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.rw, header[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := header[0] & 0x0f
+	if header[1]&0x80 == 0 {
+		return 0, nil, errors.New("websocket: client frame is not masked")
+	}
+	n := uint64(header[1] & 0x7f)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.rw, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.rw, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	if n > maxMessageSize {
+		return 0, nil, fmt.Errorf("websocket: frame of %d bytes is too large", n)
+	}
+	var mask [4]byte
+	if _, err := io.ReadFull(c.rw, mask[:]); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(c.rw, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return opcode, payload, nil
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,42 @@
+package stream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpgradeChecksOrigin(t *testing.T) {
+	origins := []string{"https://dashboard.example.com/"}
+	for _, test := range []struct {
+		origin string
+		code   int
+	}{
+		{"", http.StatusSwitchingProtocols},
+		{"http://checker.example.com:8080", http.StatusSwitchingProtocols},
+		{"https://dashboard.example.com", http.StatusSwitchingProtocols},
+		{"http://checker.example.com", http.StatusForbidden},
+		{"https://evil.example.com", http.StatusForbidden},
+		{"null", http.StatusForbidden},
+	} {
+		r := httptest.NewRequest("GET", "http://checker.example.com:8080/v1/stream", nil)
+		r.Header.Set("Connection", "Upgrade")
+		r.Header.Set("Upgrade", "websocket")
+		r.Header.Set("Sec-WebSocket-Version", "13")
+		r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		if test.origin != "" {
+			r.Header.Set("Origin", test.origin)
+		}
+		w := httptest.NewRecorder()
+		// A recorder can't be hijacked, a handshake that passes the
+		// checks fails there
+		_, err := Upgrade(w, r, origins)
+		code := w.Code
+		if err != nil && err.Error() == "response can not be hijacked" {
+			code = http.StatusSwitchingProtocols
+		}
+		if code != test.code {
+			t.Errorf("Origin %q: expected %d, got %d", test.origin, test.code, code)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/datastore"
+	"github.com/alexgear/checker/export"
+	"github.com/alexgear/checker/query"
+)
+
+// stringsFlag collects the values of a flag that is given several times.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string { return strings.Join(*f, ",") }
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// exportCommand runs "checker export", which writes raw samples, or the
+// aggregates of every tier, of the db to stdout.
+func exportCommand(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var series stringsFlag
+	fs.Var(&series, "series", "series to export, may be given several times, all if not given")
+	from := fs.String("from", "24h", "start of the range, RFC3339, now-7d or a duration before now")
+	to := fs.String("to", "now", "end of the range, RFC3339, now-7d or a duration before now")
+	format := fs.String("format", "csv", "output format, "+strings.Join(export.Formats, ", "))
+	aggregates := fs.Bool("aggregates", false, "export the aggregates of every tier instead of raw samples, as jsonl")
+	path := fs.String("db", config.C.DBPath, "path of the db")
+	fs.Parse(args)
+	if *aggregates && *format != "jsonl" {
+		return fmt.Errorf("Aggregates are only exported as jsonl")
+	}
+	now := time.Now()
+	start, err := query.ParseTime(*from, now)
+	if err != nil {
+		return err
+	}
+	end, err := query.ParseTime(*to, now)
+	if err != nil {
+		return err
+	}
+	enc, err := export.NewEncoder(os.Stdout, *format)
+	if err != nil {
+		return err
+	}
+	store, err := datastore.OpenBolt(*path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	if *aggregates {
+		n, err := export.ExportAggregates(os.Stdout, store, series, start, end)
+		if err != nil {
+			return err
+		}
+		log.Printf("Exported %d aggregates\n", n)
+		return nil
+	}
+	n, err := export.Export(enc, store, series, start, end)
+	if err != nil {
+		return err
+	}
+	log.Printf("Exported %d samples\n", n)
+	return nil
+}
+
+// importCommand runs "checker import", which adds the results, or the
+// aggregates, of the given files, or of stdin, to the db.
+func importCommand(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "csv", "input format, "+strings.Join(export.Formats, ", "))
+	aggregates := fs.Bool("aggregates", false, "import aggregates exported with -aggregates instead of raw samples")
+	path := fs.String("db", config.C.DBPath, "path of the db")
+	fs.Parse(args)
+	if *aggregates && *format != "jsonl" {
+		return fmt.Errorf("Aggregates are only imported from jsonl")
+	}
+	store, err := datastore.OpenBolt(*path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	// The results are in the files already, they need no log
+	cache, err := datastore.NewCache(store, "")
+	if err != nil {
+		return err
+	}
+	defer cache.Close()
+	// Everything cached is flushed, however recent
+	flushAll := func() error {
+		return cache.FlushBefore(time.Now().Add(time.Hour))
+	}
+	total := 0
+	write := func(r common.Result) error {
+		if r.Labels != nil {
+			cache.SetLabels(r.Series.Key(), r.Labels)
+		}
+		total++
+		if total%export.ImportFlushEvery == 0 {
+			if err := flushAll(); err != nil {
+				return err
+			}
+		}
+		return cache.Write(r.Series.Key(), r.Response)
+	}
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	for _, input := range inputs {
+		var r io.Reader = os.Stdin
+		if input != "-" {
+			f, err := os.Open(input)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			r = f
+		}
+		if *aggregates {
+			n, err := export.ImportAggregates(r, store)
+			if err != nil {
+				return err
+			}
+			log.Printf("Read %d aggregates from %s\n", n, input)
+			continue
+		}
+		dec, err := export.NewDecoder(r, *format)
+		if err != nil {
+			return err
+		}
+		n, skipped, err := export.Import(dec, store, write)
+		if err != nil {
+			return err
+		}
+		log.Printf("Read %d results from %s, skipped %d already in the db\n", n, input, skipped)
+		// Flush what is cached, so that the next input finds it in the db
+		// if it holds the same results
+		if err = flushAll(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package worker
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alexgear/checker/common"
+	"github.com/boltdb/bolt"
+)
+
+var spoolBucket = []byte("results")
+
+// spool is an on-disk FIFO of results that could not be sent to the server
+// yet. Entries are keyed by a sequence number, so iterating the bucket
+// yields them in the order they were pushed. When it is full or entries
+// get too old the oldest ones are dropped.
+type spool struct {
+	db         *bolt.DB
+	maxEntries int
+	maxAge     time.Duration
+
+	mu    sync.Mutex
+	count int
+}
+
+func openSpool(path string, maxEntries int, maxAge time.Duration) (*spool, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open spool: %s", err.Error())
+	}
+	s := &spool{db: db, maxEntries: maxEntries, maxAge: maxAge}
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(spoolBucket)
+		if err != nil {
+			return err
+		}
+		s.count = b.Stats().KeyN
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("Failed to initialize spool: %s", err.Error())
+	}
+	return s, nil
+}
+
+func (s *spool) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
+
+// push appends entries to the spool and drops the oldest entries if that
+// makes it exceed maxEntries, along with those older than maxAge, so that
+// the spool stays bounded while nothing is replayed.
+func (s *spool) push(entries ...common.Result) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spoolBucket)
+		count := s.count
+		for _, e := range entries {
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			v, err := json.Marshal(e)
+			if err != nil {
+				return fmt.Errorf("Failed to encode to json: %s", err.Error())
+			}
+			if err = b.Put(seqKey(seq), v); err != nil {
+				return err
+			}
+			count++
+		}
+		var dropped [][]byte
+		expired := time.Now().Add(-s.maxAge)
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if count <= s.maxEntries {
+				// Entries are pushed in time order, so the first one
+				// that is recent enough ends the expired ones
+				var e common.Result
+				err := json.Unmarshal(v, &e)
+				if err == nil && !e.Response.Time.Before(expired) {
+					break
+				}
+			}
+			dropped = append(dropped, append([]byte(nil), k...))
+			count--
+		}
+		for _, k := range dropped {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		s.count = count
+		return nil
+	})
+}
+
+// oldest returns up to n of the oldest entries together with their keys.
+// Entries older than maxAge are dropped instead of being returned.
+func (s *spool) oldest(n int) ([][]byte, []common.Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys, dropped [][]byte
+	var entries []common.Result
+	expired := time.Now().Add(-s.maxAge)
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spoolBucket)
+		c := b.Cursor()
+		for k, v := c.First(); k != nil && len(entries) < n; k, v = c.Next() {
+			var e common.Result
+			err := json.Unmarshal(v, &e)
+			if err != nil || e.Response.Time.Before(expired) {
+				dropped = append(dropped, append([]byte(nil), k...))
+				continue
+			}
+			keys = append(keys, append([]byte(nil), k...))
+			entries = append(entries, e)
+		}
+		for _, k := range dropped {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	s.count -= len(dropped)
+	return keys, entries, nil
+}
+
+// remove deletes entries that were sent successfully.
+func (s *spool) remove(keys [][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spoolBucket)
+		for _, k := range keys {
+			if b.Get(k) == nil {
+				continue
+			}
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	if err == nil {
+		s.count -= removed
+	}
+	return err
+}
+
+func seqKey(seq uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, seq)
+	return k
+}
@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alexgear/checker/common"
+)
+
+// testResults returns n results of a second each, the first one at start.
+func testResults(start time.Time, n int) []common.Result {
+	var rs []common.Result
+	for i := 0; i < n; i++ {
+		rs = append(rs, common.Result{
+			Series:   common.Series{Agent: "agent", Interface: "eth0", Probe: "icmp", Target: "192.0.2.1"},
+			Response: common.Response{IsUp: true, Latency: time.Millisecond, Time: start.Add(time.Duration(i) * time.Second)},
+		})
+	}
+	return rs
+}
+
+// times returns the times of results, to compare them in order.
+func times(rs []common.Result) []time.Time {
+	var ts []time.Time
+	for _, r := range rs {
+		ts = append(ts, r.Response.Time)
+	}
+	return ts
+}
+
+func openTestSpool(t *testing.T, path string, maxEntries int, maxAge time.Duration) *spool {
+	t.Helper()
+	s, err := openSpool(path, maxEntries, maxAge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.db.Close() })
+	return s
+}
+
+func TestSpoolFIFO(t *testing.T) {
+	s := openTestSpool(t, filepath.Join(t.TempDir(), "spool.db"), 100, time.Hour)
+	pushed := testResults(time.Now().UTC().Truncate(time.Second).Add(-time.Minute), 5)
+	for _, r := range pushed {
+		if err := s.push(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	keys, got, err := s.oldest(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(times(got), times(pushed[:3])) {
+		t.Errorf("Expected the oldest 3 results, got %v", times(got))
+	}
+	if err = s.remove(keys); err != nil {
+		t.Fatal(err)
+	}
+	_, got, err = s.oldest(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(times(got), times(pushed[3:])) {
+		t.Errorf("Expected the remaining 2 results, got %v", times(got))
+	}
+	if s.len() != 2 {
+		t.Errorf("Expected 2 spooled results, got %d", s.len())
+	}
+}
+
+func TestSpoolEviction(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, test := range []struct {
+		name       string
+		maxEntries int
+		pushed     []common.Result
+		want       []common.Result
+	}{
+		{"max entries", 3, testResults(now.Add(-time.Minute), 5), testResults(now.Add(-time.Minute+2*time.Second), 3)},
+		{"max age", 100, append(testResults(now.Add(-2*time.Hour), 2), testResults(now.Add(-time.Minute), 2)...), testResults(now.Add(-time.Minute), 2)},
+	} {
+		s := openTestSpool(t, filepath.Join(t.TempDir(), "spool.db"), test.maxEntries, time.Hour)
+		if err := s.push(test.pushed...); err != nil {
+			t.Fatal(err)
+		}
+		_, got, err := s.oldest(100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(times(got), times(test.want)) {
+			t.Errorf("%s: expected %v to be kept, got %v", test.name, times(test.want), times(got))
+		}
+		if s.len() != len(test.want) {
+			t.Errorf("%s: expected %d spooled results, got %d", test.name, len(test.want), s.len())
+		}
+	}
+}
+
+func TestSpoolReplaysAfterRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spool.db")
+	pushed := testResults(time.Now().UTC().Truncate(time.Second).Add(-time.Minute), 4)
+	s, err := openSpool(path, 100, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.push(pushed...); err != nil {
+		t.Fatal(err)
+	}
+	s.db.Close()
+	s = openTestSpool(t, path, 100, time.Hour)
+	if s.len() != len(pushed) {
+		t.Errorf("Expected %d spooled results after a restart, got %d", len(pushed), s.len())
+	}
+	_, got, err := s.oldest(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, pushed) {
+		t.Errorf("Replayed %+v, want %+v", got, pushed)
+	}
+}
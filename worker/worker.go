@@ -1,68 +1,282 @@
 package worker
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/alexgear/checker/common"
 	"github.com/alexgear/checker/config"
+	"github.com/alexgear/checker/metrics"
 	"github.com/alexgear/checker/network"
+	"github.com/alexgear/checker/sink"
 )
 
 var err error
 
-func producer(ief string, c chan common.Response) {
-	start := time.Now().UTC()
-	status := network.Ping(ief)
-	latency := time.Since(start)
-	r := common.Response{IsUp: status, Latency: latency, Time: start}
-	c <- r
+// queue holds the results that could not be sent yet.
+var queue *spool
+
+// results collects the results of all probes for the batcher.
+var results = make(chan common.Result, 1024)
+
+// registry counts the results of all probes for /metrics.
+var registry = metrics.NewRegistry()
+
+// sinks get the results of all probes along with the server.
+var sinks *sink.Group
+
+// client is shared by all requests to the server, so that batches reuse
+// the same keep-alive connection.
+var client = &http.Client{Timeout: 30 * time.Second}
+
+// job is a configured probe together with the series it reports to.
+type job struct {
+	prober   network.Prober
+	series   common.Series
+	labels   map[string]string
+	interval time.Duration
+	timeout  time.Duration
 }
 
-func consumer(ief string, c chan common.Response) {
+func newJob(pc config.Probe) (*job, error) {
+	options := make(map[string]string)
+	for key, value := range pc.Options {
+		options[key] = fmt.Sprint(value)
+	}
+	p, err := network.New(network.Config{
+		Name:      pc.Name,
+		Type:      pc.Type,
+		Target:    pc.Target,
+		Interface: pc.Interface,
+		Timeout:   pc.Timeout.Duration,
+		Options:   options,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s := common.Series{Agent: config.C.Agent, Interface: pc.Interface, Probe: pc.Type, Target: pc.Target}
+	if err = s.Validate(); err != nil {
+		return nil, err
+	}
+	return &job{
+		prober:   p,
+		series:   s,
+		labels:   pc.Labels,
+		interval: pc.Interval.Duration,
+		timeout:  pc.Timeout.Duration,
+	}, nil
+}
+
+// schedule starts a probe right away and then once every interval. Probes
+// that take longer than the interval overlap.
+func schedule(j *job) {
+	ticker := time.NewTicker(j.interval)
+	go producer(j)
+	for _ = range ticker.C {
+		go producer(j)
+	}
+}
+
+func producer(j *job) {
+	ctx, cancel := context.WithTimeout(context.Background(), j.timeout)
+	defer cancel()
+	r := common.Result{Series: j.series, Labels: j.labels, Response: j.prober.Probe(ctx)}
+	registry.Observe(r)
+	sinks.Write(r)
+	select {
+	case results <- r:
+	default:
+		// The batcher is stuck sending to a slow server, spool the result
+		// rather than pile up blocked probes. The batcher spools what it
+		// gets until replay has caught up, so the order is kept.
+		if err := queue.push(r); err != nil {
+			log.Println("Failed to spool result:", err.Error())
+		}
+	}
+}
+
+// batcher collects results and sends them once size of them piled up or
+// interval passed, whatever comes first. Once sending fails batches go to
+// the queue instead, until replay has caught up with it, so that the server
+// gets them in order.
+func batcher(size int, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	batch := make([]common.Result, 0, size)
 	for {
-		r := <-c
-		err = send(ief, r)
+		select {
+		case r := <-results:
+			batch = append(batch, r)
+			if len(batch) < size {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		if queue.len() == 0 {
+			err := send(batch)
+			if err == nil {
+				batch = batch[:0]
+				continue
+			}
+			if rejected(err) {
+				log.Printf("Dropping %d results: %s\n", len(batch), err.Error())
+				batch = batch[:0]
+				continue
+			}
+			log.Println("Failed to send payload, spooling results:", err.Error())
+		}
+		err := queue.push(batch...)
 		if err != nil {
-			log.Println("Failed to send payload:", err.Error())
+			log.Println("Failed to spool results:", err.Error())
 		}
+		batch = batch[:0]
 	}
 }
 
-func send(ief string, r common.Response) error {
-	client := http.Client{Timeout: 5 * time.Second}
+// replay sends the queued results oldest first in batches of size, backing
+// off while the server stays unreachable. Batches the server rejects are
+// dropped, sending them again would block the spool forever.
+func replay(size int) {
+	backoff := time.Second
+	for {
+		keys, batch, err := queue.oldest(size)
+		if err != nil {
+			log.Println("Failed to read spool:", err.Error())
+		}
+		if len(batch) == 0 {
+			time.Sleep(time.Second)
+			continue
+		}
+		err = send(batch)
+		if rejected(err) {
+			log.Printf("Dropping %d spooled results: %s\n", len(batch), err.Error())
+		} else if err != nil {
+			time.Sleep(backoff)
+			if backoff < time.Minute {
+				backoff *= 2
+			}
+			continue
+		}
+		if backoff > time.Second {
+			log.Printf("Server is back, replaying %d spooled results\n", queue.len())
+			backoff = time.Second
+		}
+		err = queue.remove(keys)
+		if err != nil {
+			log.Println("Failed to remove replayed results from spool:", err.Error())
+		}
+	}
+}
+
+// send posts a batch of results to the server as gzipped JSON.
+func send(batch []common.Result) error {
 	u, err := url.Parse(config.C.Server)
 	if err != nil {
 		return fmt.Errorf("Failed to parse url: %s", err.Error())
 	}
-	u.Path = fmt.Sprintf("/v1/%s", ief)
-	form := url.Values{}
-	form.Set("status", fmt.Sprint(r.IsUp))
-	form.Set("latency", r.Latency.String())
-	form.Set("time", r.Time.UTC().Format(time.RFC3339Nano))
-	resp, err := client.PostForm(fmt.Sprint(u), form)
+	u.Path = "/v2/results"
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	err = json.NewEncoder(gz).Encode(batch)
+	if err != nil {
+		return fmt.Errorf("Failed to encode to json: %s", err.Error())
+	}
+	err = gz.Close()
+	if err != nil {
+		return fmt.Errorf("Failed to compress payload: %s", err.Error())
+	}
+	req, err := http.NewRequest("POST", u.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("Failed to send payload: %s", err.Error())
 	}
+	// Drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Payload sent, but got %d error", resp.StatusCode)
+		return &statusError{resp.StatusCode}
 	}
 	return nil
 }
 
-func InitWorker() {
-	cWifi := make(chan common.Response)
-	cLan := make(chan common.Response)
-	go consumer("wifi", cWifi)
-	go consumer("lan", cLan)
-	ticker := time.NewTicker(200 * time.Millisecond)
-	go func() {
-		for _ = range ticker.C {
-			go producer("wifi", cWifi)
-			go producer("lan", cLan)
+// statusError is returned by send when the server did not accept a batch.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("Payload sent, but got %d error", e.code)
+}
+
+// rejected reports whether the server refused a batch for what it holds
+// rather than because it is unavailable. Only requests that timed out or
+// were rate limited are worth sending again among the 4xx responses.
+func rejected(err error) bool {
+	e, ok := err.(*statusError)
+	if !ok || e.code < 400 || e.code >= 500 {
+		return false
+	}
+	return e.code != http.StatusRequestTimeout && e.code != http.StatusTooManyRequests
+}
+
+// InitWorker starts every probe of the config on its own interval.
+func InitWorker() error {
+	var jobs []*job
+	for _, pc := range config.C.Probes {
+		j, err := newJob(pc)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	if len(jobs) == 0 {
+		return fmt.Errorf("No probes configured")
+	}
+	queue, err = openSpool(config.C.SpoolPath, config.C.SpoolMaxEntries, config.C.SpoolMaxAge.Duration)
+	if err != nil {
+		return err
+	}
+	if n := queue.len(); n > 0 {
+		log.Printf("Replaying %d spooled results\n", n)
+	}
+	if config.C.MetricsListen != "" {
+		l, err := net.Listen("tcp", config.C.MetricsListen)
+		if err != nil {
+			return fmt.Errorf("Failed to listen for metrics: %s", err.Error())
 		}
-	}()
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", registry)
+		go func() {
+			log.Println(http.Serve(l, mux))
+		}()
+		log.Printf("Serving metrics on %s/metrics\n", l.Addr())
+	}
+	sinks, err = sink.Open(config.C.Sinks)
+	if err != nil {
+		return err
+	}
+	go replay(config.C.BatchSize)
+	go batcher(config.C.BatchSize, config.C.BatchInterval.Duration)
+	for _, j := range jobs {
+		log.Printf("Probing %s every %s\n", j.series.Key(), j.interval)
+		go schedule(j)
+	}
+	return nil
 }